@@ -25,6 +25,7 @@ val services = mapOf(
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "bedrock" to ServiceSpec("Amazon Bedrock"),
+    "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chime" to ServiceSpec("Chime"),
@@ -126,6 +127,7 @@ val services = mapOf(
     "lightsail" to ServiceSpec("Lightsail", regionOverride = "us-east-1"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
+    "m2" to ServiceSpec("Mainframe Modernization", vpcLock = true),
     "macie2" to ServiceSpec("Macie"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
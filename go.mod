@@ -1,15 +1,15 @@
 module github.com/hashicorp/terraform-provider-aws
 
-go 1.20
+go 1.21
 
 require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230923063757-afb1ddc0824c
 	github.com/YakDriver/regexache v0.23.0
-	github.com/aws/aws-sdk-go v1.47.12
-	github.com/aws/aws-sdk-go-v2 v1.22.2
-	github.com/aws/aws-sdk-go-v2/config v1.20.0
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.14.3
-	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.13.2
+	github.com/aws/aws-sdk-go v1.48.8
+	github.com/aws/aws-sdk-go-v2 v1.30.4
+	github.com/aws/aws-sdk-go-v2/config v1.26.1
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.14.10
+	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.15.7
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.23.1
 	github.com/aws/aws-sdk-go-v2/service/account v1.13.1
 	github.com/aws/aws-sdk-go-v2/service/acm v1.21.1
@@ -17,10 +17,11 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/athena v1.34.1
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.29.1
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.3.1
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/chimesdkvoice v1.11.2
 	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.7.0
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.14.2
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.27.0
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.31.0
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.7.1
 	github.com/aws/aws-sdk-go-v2/service/codestarconnections v1.17.1
 	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.19.1
@@ -31,7 +32,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.21.1
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.5.1
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.133.0
-	github.com/aws/aws-sdk-go-v2/service/eks v1.33.0
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/eks v1.37.0
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.13.1
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.16.1
 	github.com/aws/aws-sdk-go-v2/service/fis v1.19.1
@@ -44,9 +46,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.26.1
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.46.1
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.6.1
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.46.0
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.57.0
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.36.1
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.31.1
+	github.com/aws/aws-sdk-go-v2/service/m2 v1.10.7
 	github.com/aws/aws-sdk-go-v2/service/mediaconnect v1.23.1
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.40.1
 	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.26.1
@@ -64,7 +67,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.18.1
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.5.1
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.19.1
-	github.com/aws/aws-sdk-go-v2/service/s3 v1.42.1
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.47.5
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.36.2
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.5.1
 	github.com/aws/aws-sdk-go-v2/service/securitylake v1.9.1
@@ -72,24 +75,25 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.23.1
 	github.com/aws/aws-sdk-go-v2/service/signer v1.18.2
 	github.com/aws/aws-sdk-go-v2/service/sns v1.25.1
-	github.com/aws/aws-sdk-go-v2/service/sqs v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/sqs v1.28.1
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.42.1
 	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.19.1
 	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.25.1
-	github.com/aws/aws-sdk-go-v2/service/sts v1.25.1
+	github.com/aws/aws-sdk-go-v2/service/sts v1.26.5
 	github.com/aws/aws-sdk-go-v2/service/swf v1.19.1
-	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.22.1
+	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.31.1
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.5.1
 	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.4.1
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.33.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.22.1
+	github.com/aws/smithy-go v1.20.4
 	github.com/beevik/etree v1.2.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/gertd/go-pluralize v0.2.1
 	github.com/google/go-cmp v0.6.0
 	github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go v0.21.0
-	github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0-beta.41
+	github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0-beta.42
 	github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2 v2.0.0-beta.42
 	github.com/hashicorp/awspolicyequivalence v1.6.0
 	github.com/hashicorp/go-cleanhttp v0.5.2
@@ -131,22 +135,21 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.5.0 // indirect
-	github.com/aws/aws-sdk-go-v2/credentials v1.16.0 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.2.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.5.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/ini v1.4.0 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.2.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.25.1 // indirect
-	github.com/aws/aws-sdk-go-v2/service/iam v1.27.1 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.10.0 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.2.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.8.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.10.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.16.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sso v1.17.1 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.19.1 // indirect
-	github.com/aws/smithy-go v1.16.0 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.4 // indirect
+	github.com/aws/aws-sdk-go-v2/credentials v1.16.12 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/ini v1.7.2 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.2.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.26.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/iam v1.27.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.10.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.2.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.8.10 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.10.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.16.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sso v1.18.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.21.5 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.6.0 // indirect
@@ -187,12 +190,12 @@ require (
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	github.com/zclconf/go-cty v1.14.1 // indirect
-	go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws v0.46.0 // indirect
-	go.opentelemetry.io/otel v1.20.0 // indirect
-	go.opentelemetry.io/otel/metric v1.20.0 // indirect
-	go.opentelemetry.io/otel/trace v1.20.0 // indirect
+	go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws v0.46.1 // indirect
+	go.opentelemetry.io/otel v1.21.0 // indirect
+	go.opentelemetry.io/otel/metric v1.21.0 // indirect
+	go.opentelemetry.io/otel/trace v1.21.0 // indirect
 	golang.org/x/mod v0.13.0 // indirect
-	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/net v0.18.0 // indirect
 	golang.org/x/sys v0.14.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
@@ -32,6 +32,7 @@ variable "service_labels" {
     "backupgateway",
     "batch",
     "bedrock",
+    "bedrockagent",
     "billingconductor",
     "braket",
     "budgets",
@@ -188,6 +189,7 @@ variable "service_labels" {
     "lookoutequipment",
     "lookoutmetrics",
     "lookoutvision",
+    "m2",
     "machinelearning",
     "macie",
     "macie2",
@@ -11,11 +11,14 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -24,6 +27,7 @@ type AWSClient struct {
 	AccountID               string
 	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
+	DriftDiagnosticsConfig  *DriftDiagnosticsConfig
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
 	Partition               string
@@ -37,6 +41,7 @@ type AWSClient struct {
 	clients                   map[string]any
 	conns                     map[string]any
 	endpoints                 map[string]string // From provider configuration.
+	endpointsFile             *endpointsFile    // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
@@ -64,6 +69,27 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s", prefix, client.DNSSuffix)
 }
 
+// RegionFromContext returns the AWS Region that API calls made using the specified Context are for.
+// This is the per-resource Region, if any, otherwise the provider's configured Region.
+func (client *AWSClient) RegionFromContext(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.Region != "" {
+		return inContext.Region
+	}
+	return client.Region
+}
+
+// ResourceRegion returns the AWS Region in which the specified resource or data source is managed.
+// This is the value of its per-resource `region` attribute, if set, otherwise the provider's configured Region.
+// d is the resource's *schema.ResourceData or *schema.ResourceDiff.
+func (client *AWSClient) ResourceRegion(d interface{ GetOk(string) (any, bool) }) string {
+	if v, ok := d.GetOk(names.AttrRegion); ok {
+		if v, ok := v.(string); ok && v != "" {
+			return v
+		}
+	}
+	return client.Region
+}
+
 // RegionalHostname returns a hostname with the provider domain suffix for the region and partition
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -76,6 +102,19 @@ func (client *AWSClient) S3UsePathStyle() bool {
 	return client.s3UsePathStyle
 }
 
+// S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express One Zone directory buckets.
+// Directory buckets are only reachable via Regional and Zonal endpoints, so in us-east-1 the returned client
+// never uses the global S3 endpoint, regardless of the s3_us_east_1_regional_endpoint provider configuration.
+func (client *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
+	s3Client := client.S3Client(ctx)
+
+	if s3Client.Options().Region != names.GlobalRegionID {
+		return s3Client
+	}
+
+	return errs.Must(s3ExpressClient(ctx, client))
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
@@ -151,17 +190,53 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
-// apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+// apiClientConfig returns the AWS API client configuration parameters for the specified service and Region.
+func (client *AWSClient) apiClientConfig(servicePackageName, region string) map[string]any {
+	endpoint := client.endpoints[servicePackageName]
+	s3UsePathStyle := client.s3UsePathStyle
+	// The signing Region only affects how requests are signed, never the client's Region.
+	var signingRegion string
+	// Endpoints configured in the provider `endpoints` block take precedence over the endpoints file.
+	if endpoint == "" && client.endpointsFile != nil {
+		if v, ok := client.endpointsFile.endpoint(servicePackageName, region); ok {
+			endpoint = v.URL
+			if v.SigningRegion != region {
+				signingRegion = v.SigningRegion
+			}
+			if v.S3UsePathStyle != nil {
+				s3UsePathStyle = aws_sdkv2.ToBool(v.S3UsePathStyle)
+			}
+		}
+	}
+
 	m := map[string]any{
 		"aws_sdkv2_config": client.awsConfig,
-		"endpoint":         client.endpoints[servicePackageName],
+		"endpoint":         endpoint,
 		"partition":        client.Partition,
 		"session":          client.Session,
 	}
+	if region != client.Region || signingRegion != "" {
+		if client.awsConfig != nil {
+			cfg := client.awsConfig.Copy()
+			cfg.Region = region
+			if signingRegion != "" {
+				// Don't append to the API options shared with the original configuration.
+				n := len(cfg.APIOptions)
+				cfg.APIOptions = append(cfg.APIOptions[:n:n], addSigningRegionMiddleware(signingRegion))
+			}
+			m["aws_sdkv2_config"] = &cfg
+		}
+		if client.Session != nil {
+			sess := client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+			if signingRegion != "" {
+				sess.Handlers.Sign.PushFrontNamed(signingRegionHandler(signingRegion))
+			}
+			m["session"] = sess
+		}
+	}
 	switch servicePackageName {
 	case names.S3:
-		m["s3_use_path_style"] = client.s3UsePathStyle
+		m["s3_use_path_style"] = s3UsePathStyle
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
 		// For compatibility, read it now.
 		if client.s3UsEast1RegionalEndpoint == endpoints_sdkv1.UnsetS3UsEast1Endpoint {
@@ -177,12 +252,24 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 	return m
 }
 
+// apiClientKey returns the key under which the API client for the specified service and Region is cached.
+func (client *AWSClient) apiClientKey(servicePackageName, region string) string {
+	if region == client.Region {
+		return servicePackageName
+	}
+	return servicePackageName + "/" + region
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// The client is for the Region that API calls made using the specified Context are for.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.conns[servicePackageName]; ok {
+	region := c.RegionFromContext(ctx)
+	key := c.apiClientKey(servicePackageName, region)
+
+	if raw, ok := c.conns[key]; ok {
 		if conn, ok := raw.(T); ok {
 			return conn, nil
 		} else {
@@ -205,7 +292,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName))
+	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -221,17 +308,21 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		}
 	}
 
-	c.conns[servicePackageName] = conn
+	c.conns[key] = conn
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// The client is for the Region that API calls made using the specified Context are for.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.clients[servicePackageName]; ok {
+	region := c.RegionFromContext(ctx)
+	key := c.apiClientKey(servicePackageName, region)
+
+	if raw, ok := c.clients[key]; ok {
 		if client, ok := raw.(T); ok {
 			return client, nil
 		} else {
@@ -254,7 +345,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName))
+	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -262,7 +353,47 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
-	c.clients[servicePackageName] = client
+	c.clients[key] = client
+
+	return client, nil
+}
+
+// s3ExpressClient returns the AWS SDK for Go v2 S3 API client configured to use the Regional S3 endpoint in us-east-1.
+func s3ExpressClient(ctx context.Context, c *AWSClient) (*s3_sdkv2.Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	region := c.RegionFromContext(ctx)
+	key := c.apiClientKey(names.S3, region) + "/express"
+
+	if raw, ok := c.clients[key]; ok {
+		if client, ok := raw.(*s3_sdkv2.Client); ok {
+			return client, nil
+		}
+		return nil, fmt.Errorf("AWS SDK v2 API client (%s): %T, want %T", names.S3, raw, (*s3_sdkv2.Client)(nil))
+	}
+
+	sp, ok := c.ServicePackages[names.S3]
+	if !ok {
+		return nil, fmt.Errorf("unknown service package: %s", names.S3)
+	}
+
+	v, ok := sp.(interface {
+		NewClient(context.Context, map[string]any) (*s3_sdkv2.Client, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("no AWS SDK v2 API client factory: %s", names.S3)
+	}
+
+	config := c.apiClientConfig(names.S3, region)
+	config["s3_us_east_1_regional_endpoint"] = endpoints_sdkv1.RegionalS3UsEast1Endpoint
+
+	client, err := v.NewClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.clients[key] = client
 
 	return client, nil
 }
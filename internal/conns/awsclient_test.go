@@ -4,7 +4,11 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -90,3 +94,120 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionFromContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	ctx := NewResourceContext(context.Background(), "test", "Thing")
+
+	testCases := []struct {
+		Name        string
+		Context     context.Context
+		Expected    string
+		ExpectedKey string
+	}{
+		{
+			Name:        "no resource",
+			Context:     context.Background(),
+			Expected:    "us-west-2", //lintignore:AWSAT003
+			ExpectedKey: "test",
+		},
+		{
+			Name:        "no per-resource Region",
+			Context:     ctx,
+			Expected:    "us-west-2", //lintignore:AWSAT003
+			ExpectedKey: "test",
+		},
+		{
+			Name:        "per-resource Region",
+			Context:     NewRegionalContext(ctx, "eu-west-1"), //lintignore:AWSAT003
+			Expected:    "eu-west-1",                          //lintignore:AWSAT003
+			ExpectedKey: "test/eu-west-1",                     //lintignore:AWSAT003
+		},
+		{
+			Name:        "per-resource Region same as provider",
+			Context:     NewRegionalContext(ctx, "us-west-2"), //lintignore:AWSAT003
+			Expected:    "us-west-2",                          //lintignore:AWSAT003
+			ExpectedKey: "test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := client.RegionFromContext(testCase.Context)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got, want := client.apiClientKey("test", got), testCase.ExpectedKey; got != want {
+				t.Errorf("got key %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientAPIClientConfigSigningRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	f, err := parseEndpointsFile([]byte(`{"sqs": {"url": "http://localhost:4566", "signing_region": "us-east-1"}, "sns": "http://localhost:4566"}`)) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("parsing endpoints file: %s", err)
+	}
+
+	client := &AWSClient{
+		Region:        "us-west-2",                            //lintignore:AWSAT003
+		awsConfig:     &aws_sdkv2.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+		endpointsFile: f,
+	}
+
+	testCases := []struct {
+		Name               string
+		ServicePackageName string
+		ExpectedAPIOptions int
+	}{
+		{
+			Name:               "signing Region",
+			ServicePackageName: names.SQS,
+			ExpectedAPIOptions: 1,
+		},
+		{
+			Name:               "no signing Region",
+			ServicePackageName: names.SNS,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			m := client.apiClientConfig(testCase.ServicePackageName, client.Region)
+
+			if got, want := m["endpoint"].(string), "http://localhost:4566"; got != want {
+				t.Errorf("got endpoint %s, expected %s", got, want)
+			}
+
+			cfg := m["aws_sdkv2_config"].(*aws_sdkv2.Config)
+
+			if got, want := cfg.Region, client.Region; got != want {
+				t.Errorf("got Region %s, expected %s", got, want)
+			}
+
+			if got, want := len(cfg.APIOptions), testCase.ExpectedAPIOptions; got != want {
+				t.Errorf("got %d API options, expected %d", got, want)
+			}
+
+			if got := len(client.awsConfig.APIOptions); got != 0 {
+				t.Errorf("provider configuration modified, got %d API options", got)
+			}
+		})
+	}
+}
@@ -30,10 +30,12 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DriftDiagnosticsConfig         *DriftDiagnosticsConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
+	EndpointsFile                  string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
@@ -66,6 +68,36 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	var endpointsFile *endpointsFile
+	if c.EndpointsFile != "" {
+		var err error
+		endpointsFile, err = loadEndpointsFile(c.EndpointsFile)
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if c.Endpoints == nil {
+			c.Endpoints = make(map[string]string)
+		}
+
+		// Endpoints used during provider configuration.
+		// Endpoints configured in the provider `endpoints` block take precedence.
+		for _, pkg := range []string{names.IAM, names.SSO, names.STS} {
+			if c.Endpoints[pkg] != "" {
+				continue
+			}
+
+			if v, ok := endpointsFile.endpoint(pkg, c.Region); ok {
+				c.Endpoints[pkg] = v.URL
+
+				if pkg == names.STS && c.STSRegion == "" && v.SigningRegion != "" {
+					c.STSRegion = v.SigningRegion
+				}
+			}
+		}
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		AllowedAccountIds:             c.AllowedAccountIds,
@@ -150,6 +182,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	// Redact sensitive request and response fields from AWS SDK for Go v2 API client logs.
+	cfg.APIOptions = append(cfg.APIOptions, addRedactSensitiveFieldsMiddleware)
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -167,6 +202,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Redact sensitive request and response fields from AWS SDK for Go v1 API client logs.
+	sess.Handlers.Send.PushFrontNamed(redactSensitiveFieldsHandler)
+
+	if endpointsFile != nil {
+		sess.Config.EndpointResolver = endpointsFile.endpointsResolverV1(sess.Config.EndpointResolver)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -196,6 +238,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
+	client.DriftDiagnosticsConfig = c.DriftDiagnosticsConfig
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
@@ -209,6 +252,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.endpointsFile = endpointsFile
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3UsEast1RegionalEndpoint = c.S3UsEast1RegionalEndpoint
@@ -217,6 +261,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	return client, diags
 }
 
+// DriftDiagnosticsConfig contains the provider configuration for reporting resource changes made outside of Terraform.
+type DriftDiagnosticsConfig struct {
+	CloudTrailLookup bool // Look up the most recent write event for a drifted resource in CloudTrail event history.
+}
+
 func baseSeverityToSdkSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
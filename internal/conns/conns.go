@@ -36,6 +36,7 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	Region             string // Per-resource Region, if different from the provider's configured Region
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
@@ -64,6 +65,18 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// NewRegionalContext returns a copy of the resource information in Context with the specified per-resource Region.
+// AWS API clients obtained using the returned Context are for that Region.
+func NewRegionalContext(ctx context.Context, region string) context.Context {
+	var v InContext
+	if inContext, ok := FromContext(ctx); ok {
+		v = *inContext
+	}
+	v.Region = region
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewSessionForRegion(cfg *aws_sdkv1.Config, region, terraformVersion string) (*session_sdkv1.Session, error) {
 	session, err := session_sdkv1.NewSession(cfg)
 
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// endpointsFileService is a service's entry in an endpoints file.
+type endpointsFileService struct {
+	URL            string `json:"url"`
+	SigningRegion  string `json:"signing_region,omitempty"`
+	S3UsePathStyle *bool  `json:"s3_use_path_style,omitempty"`
+}
+
+func (s *endpointsFileService) UnmarshalJSON(b []byte) error {
+	// A service's entry is either the endpoint URL or an object.
+	var url string
+	if err := json.Unmarshal(b, &url); err == nil {
+		s.URL = url
+		return nil
+	}
+
+	type service endpointsFileService
+	var v service
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*s = endpointsFileService(v)
+
+	return nil
+}
+
+// endpointsFile represents the contents of the provider's endpoints file.
+// The file is either in the AWS partitions (endpoints.json) format or
+// a map of service names, as used in the provider `endpoints` configuration block, to endpoints.
+type endpointsFile struct {
+	resolver endpoints_sdkv1.Resolver        // Partitions format.
+	services map[string]endpointsFileService // Simplified format, keyed by service package name.
+}
+
+// loadEndpointsFile reads and parses the endpoints file at the specified path.
+func loadEndpointsFile(path string) (*endpointsFile, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading endpoints file (%s): %w", path, err)
+	}
+
+	f, err := parseEndpointsFile(b)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoints file (%s): %w", path, err)
+	}
+
+	return f, nil
+}
+
+func parseEndpointsFile(b []byte) (*endpointsFile, error) {
+	var m map[string]json.RawMessage
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	if _, ok := m["partitions"]; ok {
+		resolver, err := endpoints_sdkv1.DecodeModel(bytes.NewReader(b))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &endpointsFile{resolver: resolver}, nil
+	}
+
+	f := &endpointsFile{
+		services: make(map[string]endpointsFileService),
+	}
+
+	for k, v := range m {
+		pkg, err := names.ProviderPackageForAlias(k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var service endpointsFileService
+		if err := json.Unmarshal(v, &service); err != nil {
+			return nil, fmt.Errorf("service (%s): %w", k, err)
+		}
+
+		if service.URL == "" {
+			return nil, fmt.Errorf("service (%s): no endpoint URL", k)
+		}
+
+		f.services[pkg] = service
+	}
+
+	return f, nil
+}
+
+// endpoint returns the endpoint for the specified service package and Region.
+func (f *endpointsFile) endpoint(servicePackageName, region string) (endpointsFileService, bool) {
+	if f.resolver != nil {
+		// Only use the file's endpoint if the file actually defines the service in the Region,
+		// rather than one derived from the partition's default hostname template.
+		v, err := f.resolver.EndpointFor(names.EndpointsID(servicePackageName), region, endpoints_sdkv1.StrictMatchingOption)
+
+		if err != nil {
+			return endpointsFileService{}, false
+		}
+
+		return endpointsFileService{
+			URL:           v.URL,
+			SigningRegion: v.SigningRegion,
+		}, true
+	}
+
+	v, ok := f.services[servicePackageName]
+
+	return v, ok
+}
+
+// endpointsResolverV1 returns an AWS SDK for Go v1 endpoint resolver that resolves endpoints from the file,
+// falling back to the specified resolver for services not in the file.
+func (f *endpointsFile) endpointsResolverV1(fallback endpoints_sdkv1.Resolver) endpoints_sdkv1.Resolver {
+	if fallback == nil {
+		fallback = endpoints_sdkv1.DefaultResolver()
+	}
+
+	if f.resolver != nil {
+		return endpoints_sdkv1.ResolverFunc(func(service, region string, opts ...func(*endpoints_sdkv1.Options)) (endpoints_sdkv1.ResolvedEndpoint, error) {
+			if v, err := f.resolver.EndpointFor(service, region, append(opts, endpoints_sdkv1.StrictMatchingOption)...); err == nil {
+				return v, nil
+			}
+
+			return fallback.EndpointFor(service, region, opts...)
+		})
+	}
+
+	// Map endpoint IDs back to service package names.
+	pkgs := make(map[string]string, len(f.services))
+	for pkg := range f.services {
+		pkgs[names.EndpointsID(pkg)] = pkg
+	}
+
+	return endpoints_sdkv1.ResolverFunc(func(service, region string, opts ...func(*endpoints_sdkv1.Options)) (endpoints_sdkv1.ResolvedEndpoint, error) {
+		pkg, ok := pkgs[service]
+		if !ok {
+			pkg, _ = names.ProviderPackageForAlias(service)
+		}
+
+		if v, ok := f.services[pkg]; ok {
+			signingRegion := region
+			if v.SigningRegion != "" {
+				signingRegion = v.SigningRegion
+			}
+
+			return endpoints_sdkv1.ResolvedEndpoint{
+				URL:                v.URL,
+				SigningRegion:      signingRegion,
+				SigningNameDerived: true,
+			}, nil
+		}
+
+		return fallback.EndpointFor(service, region, opts...)
+	})
+}
+
+// signingRegionHandler returns an AWS SDK for Go v1 request handler that signs requests for the specified Region,
+// which may differ from the client's Region, e.g. when an endpoints file entry has a signing Region.
+// It must be at the front of the Sign handler list.
+func signingRegionHandler(region string) request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TF_AWS_SigningRegion",
+		Fn: func(r *request_sdkv1.Request) {
+			r.ClientInfo.SigningRegion = region
+		},
+	}
+}
+
+// addSigningRegionMiddleware returns an AWS SDK for Go v2 API option that signs requests for the specified Region.
+// The middleware is added at the front of the Finalize step, after any endpoint resolution in the Serialize step
+// and before the signing options are resolved.
+func addSigningRegionMiddleware(region string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TF_AWS_SigningRegion", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			return next.HandleFinalize(awsmiddleware_sdkv2.SetSigningRegion(ctx, region), in)
+		}), middleware.Before)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testEndpointsFilePartitions = `{
+  "partitions": [{
+    "partition": "test",
+    "partitionName": "Test",
+    "dnsSuffix": "example.com",
+    "regionRegex": "^test\\-\\w+\\-\\d+$",
+    "defaults": {
+      "hostname": "{service}.{region}.{dnsSuffix}",
+      "protocols": ["https"],
+      "signatureVersions": ["v4"]
+    },
+    "regions": {
+      "test-west-1": {"description": "Test West"}
+    },
+    "services": {
+      "config": {
+        "endpoints": {
+          "test-west-1": {"hostname": "config.example.com"}
+        }
+      },
+      "monitoring": {
+        "endpoints": {
+          "test-west-1": {
+            "hostname": "cloudwatch.example.com",
+            "credentialScope": {"region": "test-east-1"}
+          }
+        }
+      }
+    }
+  }],
+  "version": 3
+}`
+
+func TestParseEndpointsFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		service     string
+		region      string
+		expected    endpointsFileService
+		expectedOK  bool
+		expectError bool
+	}{
+		{
+			name:    "simplified URL",
+			content: `{"sqs": "http://localhost:4566"}`,
+			service: names.SQS,
+			region:  "us-west-2", //lintignore:AWSAT003
+			expected: endpointsFileService{
+				URL: "http://localhost:4566",
+			},
+			expectedOK: true,
+		},
+		{
+			name:    "simplified object",
+			content: `{"s3": {"url": "http://localhost:9000", "signing_region": "us-east-1", "s3_use_path_style": true}}`, //lintignore:AWSAT003
+			service: names.S3,
+			region:  "us-west-2", //lintignore:AWSAT003
+			expected: endpointsFileService{
+				URL:            "http://localhost:9000",
+				SigningRegion:  "us-east-1", //lintignore:AWSAT003
+				S3UsePathStyle: aws_sdkv2.Bool(true),
+			},
+			expectedOK: true,
+		},
+		{
+			name:    "simplified alias",
+			content: `{"cloudwatchevents": "http://localhost:4566"}`,
+			service: names.Events,
+			region:  "us-west-2", //lintignore:AWSAT003
+			expected: endpointsFileService{
+				URL: "http://localhost:4566",
+			},
+			expectedOK: true,
+		},
+		{
+			name:    "simplified not found",
+			content: `{"sqs": "http://localhost:4566"}`,
+			service: names.SNS,
+			region:  "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:        "simplified unknown service",
+			content:     `{"doesnotexist": "http://localhost:4566"}`,
+			expectError: true,
+		},
+		{
+			name:        "simplified no URL",
+			content:     `{"sqs": {"signing_region": "us-east-1"}}`, //lintignore:AWSAT003
+			expectError: true,
+		},
+		{
+			name:    "partitions",
+			content: testEndpointsFilePartitions,
+			service: names.CloudWatch,
+			region:  "test-west-1",
+			expected: endpointsFileService{
+				URL:           "https://cloudwatch.example.com",
+				SigningRegion: "test-east-1",
+			},
+			expectedOK: true,
+		},
+		{
+			name:    "partitions endpoint ID",
+			content: testEndpointsFilePartitions,
+			service: names.ConfigService,
+			region:  "test-west-1",
+			expected: endpointsFileService{
+				URL:           "https://config.example.com",
+				SigningRegion: "test-west-1",
+			},
+			expectedOK: true,
+		},
+		{
+			name:    "partitions service not defined",
+			content: testEndpointsFilePartitions,
+			service: names.SQS,
+			region:  "test-west-1",
+		},
+		{
+			name:    "partitions Region not defined",
+			content: testEndpointsFilePartitions,
+			service: names.CloudWatch,
+			region:  "test-east-2",
+		},
+		{
+			name:        "invalid JSON",
+			content:     `{`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := parseEndpointsFile([]byte(testCase.content))
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.expectError {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+				return
+			}
+
+			got, ok := f.endpoint(testCase.service, testCase.region)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("got found %t, expected %t", ok, testCase.expectedOK)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+// redactSensitiveFieldsHandler masks the values of sensitive fields in AWS SDK for Go v1 API request and response logs.
+// It must run before the request logger, which is at the front of the Send handler list.
+var redactSensitiveFieldsHandler = request_sdkv1.NamedHandler{
+	Name: "TF_AWS_RedactSensitiveFields",
+	Fn: func(r *request_sdkv1.Request) {
+		r.SetContext(logging.MaskSensitiveFields(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name))
+	},
+}
+
+// redactSensitiveFieldsMiddleware masks the values of sensitive fields in AWS SDK for Go v2 API request and response logs.
+type redactSensitiveFieldsMiddleware struct{}
+
+// ID is the middleware identifier.
+func (redactSensitiveFieldsMiddleware) ID() string {
+	return "TF_AWS_RedactSensitiveFields"
+}
+
+func (redactSensitiveFieldsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	ctx = logging.MaskSensitiveFields(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx))
+
+	return next.HandleInitialize(ctx, in)
+}
+
+// addRedactSensitiveFieldsMiddleware adds redactSensitiveFieldsMiddleware to an AWS SDK for Go v2 API client middleware stack.
+// It is added after the service metadata is registered and so before the request-response logger in the Deserialize step.
+func addRedactSensitiveFieldsMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(redactSensitiveFieldsMiddleware{}, middleware.After)
+}
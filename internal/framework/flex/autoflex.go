@@ -76,6 +76,18 @@ type autoExpander struct{}
 
 type autoFlattener struct{}
 
+// Expander is implemented by nested Object types that expand themselves.
+// AutoFlEx uses it to expand into AWS API union (interface) types.
+type Expander interface {
+	Expand(ctx context.Context) (any, diag.Diagnostics)
+}
+
+// Flattener is implemented by nested Object types that flatten themselves.
+// AutoFlEx uses it to flatten from AWS API union (interface) types.
+type Flattener interface {
+	Flatten(ctx context.Context, v any) diag.Diagnostics
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -672,7 +684,21 @@ func (expander autoExpander) nestedObject(ctx context.Context, vFrom fwtypes.Nes
 				diags.Append(expander.nestedObjectToSlice(ctx, vFrom, tTo, tElem, vTo)...)
 				return diags
 			}
+
+		case reflect.Interface:
+			//
+			// types.List(OfObject) -> []interface.
+			//
+			diags.Append(expander.nestedObjectToSliceOfInterface(ctx, vFrom, tTo, tElem, vTo)...)
+			return diags
 		}
+
+	case reflect.Interface:
+		//
+		// types.List(OfObject) -> interface.
+		//
+		diags.Append(expander.nestedObjectToInterface(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
 	diags.AddError("Incompatible types", fmt.Sprintf("nestedObject[%s] cannot be expanded to %s", vFrom.Type(ctx).(attr.TypeWithElementType).ElementType(), vTo.Kind()))
@@ -743,6 +769,98 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToInterface copies a Plugin Framework NestedObjectValue to a compatible AWS API interface (union) value.
+// The nested Object must implement Expander.
+func (expander autoExpander) nestedObjectToInterface(ctx context.Context, vFrom fwtypes.NestedObjectValue, tInterface reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if reflect.ValueOf(from).IsNil() {
+		return diags
+	}
+
+	v, ok := from.(Expander)
+	if !ok {
+		diags.AddError("Incompatible types", fmt.Sprintf("%T does not implement Expander", from))
+		return diags
+	}
+
+	to, d := v.Expand(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// No union member set.
+	if to == nil {
+		return diags
+	}
+
+	valTo := reflect.ValueOf(to)
+	if !valTo.Type().Implements(tInterface) {
+		diags.AddError("Incompatible types", fmt.Sprintf("%T cannot be expanded to %s", to, tInterface))
+		return diags
+	}
+
+	vTo.Set(valTo)
+
+	return diags
+}
+
+// nestedObjectToSliceOfInterface copies a Plugin Framework NestedObjectValue to a compatible AWS API []interface (union) value.
+// Each nested Object must implement Expander.
+func (expander autoExpander) nestedObjectToSliceOfInterface(ctx context.Context, vFrom fwtypes.NestedObjectValue, tSlice, tInterface reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		v, ok := f.Index(i).Interface().(Expander)
+		if !ok {
+			diags.AddError("Incompatible types", fmt.Sprintf("%s does not implement Expander", f.Index(i).Type()))
+			return diags
+		}
+
+		to, d := v.Expand(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// No union member set.
+		if to == nil {
+			continue
+		}
+
+		valTo := reflect.ValueOf(to)
+		if !valTo.Type().Implements(tInterface) {
+			diags.AddError("Incompatible types", fmt.Sprintf("%T cannot be expanded to %s", to, tInterface))
+			return diags
+		}
+
+		t = reflect.Append(t, valTo)
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
 // convert converts a single AWS API value to its Plugin Framework equivalent.
 func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -782,6 +900,10 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	case reflect.Map:
 		diags.Append(flattener.map_(ctx, vFrom, tTo, vTo)...)
 		return diags
+
+	case reflect.Interface:
+		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -1104,6 +1226,15 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 			diags.Append(flattener.sliceOfStructNestedObject(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
+
+	case reflect.Interface:
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+			//
+			// []interface -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfInterfaceNestedObject(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -1198,6 +1329,61 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 	return diags
 }
 
+// interface_ copies an AWS API interface (union) value to a compatible Plugin Framework value.
+// The target nested Object must implement Flattener.
+func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tNestedObject, ok := tTo.(fwtypes.NestedObjectType)
+	if !ok {
+		tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+			"from": vFrom.Kind(),
+			"to":   tTo,
+		})
+
+		return diags
+	}
+
+	if vFrom.IsNil() {
+		val, d := tNestedObject.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and let it flatten the union member.
+	to, d := tNestedObject.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	v, ok := to.(Flattener)
+	if !ok {
+		diags.AddError("Incompatible types", fmt.Sprintf("%T does not implement Flattener", to))
+		return diags
+	}
+
+	diags.Append(v.Flatten(ctx, vFrom.Interface())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tNestedObject.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
 // ptrToStructNestedObject copies an AWS API *struct value to a compatible Plugin Framework NestedObjectValue value.
 func (flattener autoFlattener) ptrToStructNestedObject(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1285,3 +1471,60 @@ func (flattener autoFlattener) sliceOfStructNestedObject(ctx context.Context, vF
 	vTo.Set(reflect.ValueOf(val))
 	return diags
 }
+
+// sliceOfInterfaceNestedObject copies an AWS API []interface (union) value to a compatible Plugin Framework NestedObjectValue value.
+// The target nested Object must implement Flattener.
+func (flattener autoFlattener) sliceOfInterfaceNestedObject(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and let each element flatten its union member.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		v, ok := target.(Flattener)
+		if !ok {
+			diags.AddError("Incompatible types", fmt.Sprintf("%T does not implement Flattener", target))
+			return diags
+		}
+
+		diags.Append(v.Flatten(ctx, vFrom.Index(i).Interface())...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
@@ -85,6 +86,38 @@ type TestFlexTF10 struct {
 	FieldURL types.String `tfsdk:"field_url"`
 }
 
+// TestFlexTF11 testing for AWS API union types
+type TestFlexTF11 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexTF12] `tfsdk:"field1"`
+}
+
+type TestFlexTF12 struct {
+	Member1 types.String `tfsdk:"member1"`
+	Member2 types.Int64  `tfsdk:"member2"`
+}
+
+func (m *TestFlexTF12) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	switch {
+	case !m.Member1.IsNull():
+		return &TestFlexAWSUnionMember1{Value: m.Member1.ValueString()}, nil
+	case !m.Member2.IsNull():
+		return &TestFlexAWSUnionMember2{Value: m.Member2.ValueInt64()}, nil
+	}
+
+	return nil, nil
+}
+
+func (m *TestFlexTF12) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	switch v := v.(type) {
+	case *TestFlexAWSUnionMember1:
+		m.Member1 = types.StringValue(v.Value)
+	case *TestFlexAWSUnionMember2:
+		m.Member2 = types.Int64Value(v.Value)
+	}
+
+	return nil
+}
+
 type TestFlexAWS01 struct {
 	Field1 string
 }
@@ -158,6 +191,30 @@ type TestFlexAWS12 struct {
 	FieldUrl *string
 }
 
+type TestFlexAWS13 struct {
+	Field1 TestFlexAWSUnion
+}
+
+type TestFlexAWS14 struct {
+	Field1 []TestFlexAWSUnion
+}
+
+type TestFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type TestFlexAWSUnionMember1 struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMember1) isTestFlexAWSUnion() {}
+
+type TestFlexAWSUnionMember2 struct {
+	Value int64
+}
+
+func (*TestFlexAWSUnionMember2) isTestFlexAWSUnion() {}
+
 func TestGenericExpand(t *testing.T) {
 	t.Parallel()
 
@@ -520,6 +577,36 @@ func TestGenericExpand(t *testing.T) {
 				FieldUrl: aws.String("h"),
 			},
 		},
+		{
+			TestName:   "union Source and interface Target",
+			Source:     &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF12{Member1: types.StringValue("a"), Member2: types.Int64Null()})},
+			Target:     &TestFlexAWS13{},
+			WantTarget: &TestFlexAWS13{Field1: &TestFlexAWSUnionMember1{Value: "a"}},
+		},
+		{
+			TestName:   "empty union Source and interface Target",
+			Source:     &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []TestFlexTF12{})},
+			Target:     &TestFlexAWS13{},
+			WantTarget: &TestFlexAWS13{},
+		},
+		{
+			TestName: "union list Source and interface slice Target",
+			Source: &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexTF12{
+				{Member1: types.StringValue("a"), Member2: types.Int64Null()},
+				{Member1: types.StringNull(), Member2: types.Int64Value(42)},
+			})},
+			Target: &TestFlexAWS14{},
+			WantTarget: &TestFlexAWS14{Field1: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMember1{Value: "a"},
+				&TestFlexAWSUnionMember2{Value: 42},
+			}},
+		},
+		{
+			TestName: "does not implement Expander Source",
+			Source:   &TestFlexTF05{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")})},
+			Target:   &TestFlexAWS13{},
+			WantErr:  true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -963,6 +1050,42 @@ func TestGenericFlatten(t *testing.T) {
 				FieldURL: types.StringValue("h"),
 			},
 		},
+		{
+			TestName:   "interface Source and union Target",
+			Source:     &TestFlexAWS13{Field1: &TestFlexAWSUnionMember2{Value: 42}},
+			Target:     &TestFlexTF11{},
+			WantTarget: &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF12{Member1: types.StringNull(), Member2: types.Int64Value(42)})},
+		},
+		{
+			TestName:   "nil interface Source and union Target",
+			Source:     &TestFlexAWS13{},
+			Target:     &TestFlexTF11{},
+			WantTarget: &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF12](ctx)},
+		},
+		{
+			TestName: "interface slice Source and union list Target",
+			Source: &TestFlexAWS14{Field1: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMember1{Value: "a"},
+				&TestFlexAWSUnionMember2{Value: 42},
+			}},
+			Target: &TestFlexTF11{},
+			WantTarget: &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfSlice(ctx, []*TestFlexTF12{
+				{Member1: types.StringValue("a"), Member2: types.Int64Null()},
+				{Member1: types.StringNull(), Member2: types.Int64Value(42)},
+			})},
+		},
+		{
+			TestName:   "nil interface slice Source and union list Target",
+			Source:     &TestFlexAWS14{},
+			Target:     &TestFlexTF11{},
+			WantTarget: &TestFlexTF11{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF12](ctx)},
+		},
+		{
+			TestName: "does not implement Flattener Target",
+			Source:   &TestFlexAWS13{Field1: &TestFlexAWSUnionMember1{Value: "a"}},
+			Target:   &TestFlexTF05{},
+			WantErr:  true,
+		},
 	}
 
 	for _, testCase := range testCases {
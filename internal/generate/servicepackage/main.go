@@ -128,6 +128,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ImportByARN             bool
+	ImportByARNService      string
+	ImportByARNResource     string
+	ImportByARNID           string
 }
 
 type ServiceDatum struct {
@@ -211,6 +215,32 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ImportByARN" {
+			args := common.ParseArgs(m[3])
+
+			if d.ImportByARN {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple ImportByARN annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ImportByARN = true
+
+			if attr, ok := args.Keyword["service"]; ok {
+				d.ImportByARNService = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no ARN service: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["resource"]; ok {
+				d.ImportByARNResource = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no ARN resource format: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["id"]; ok {
+				d.ImportByARNID = attr
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -281,7 +311,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ImportByARN", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
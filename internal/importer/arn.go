@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var placeholderRegex = regexp.MustCompile(`\{([0-9A-Za-z_]*)\}`)
+
+// ResourceIDFromARN returns the resource ID encoded in the specified ARN.
+// The ARN must be in the resource's format and for the provider's partition and account.
+// Its Region must be the effective Region of API calls made using the specified Context.
+func ResourceIDFromARN(ctx context.Context, client *conns.AWSClient, v *types.ServicePackageResourceImportByARN, s string) (string, error) {
+	arn, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	if client != nil {
+		if arn.Partition != client.Partition {
+			return "", fmt.Errorf("ARN (%s) partition (%s) does not match provider partition (%s)", s, arn.Partition, client.Partition)
+		}
+
+		if region := client.RegionFromContext(ctx); arn.Region != "" && arn.Region != region {
+			return "", fmt.Errorf("ARN (%s) Region (%s) does not match Region (%s)", s, arn.Region, region)
+		}
+
+		if arn.AccountID != "" && client.AccountID != "" && arn.AccountID != client.AccountID {
+			return "", fmt.Errorf("ARN (%s) account ID (%s) does not match provider account ID (%s)", s, arn.AccountID, client.AccountID)
+		}
+	}
+
+	if arn.Service != v.Service {
+		return "", fmt.Errorf("ARN (%s) service (%s) is not %s", s, arn.Service, v.Service)
+	}
+
+	re, names, err := resourceFormatRegex(v.Resource)
+
+	if err != nil {
+		return "", err
+	}
+
+	m := re.FindStringSubmatch(arn.Resource)
+
+	if m == nil {
+		return "", fmt.Errorf("ARN (%s) resource (%s) does not match expected format (%s)", s, arn.Resource, v.Resource)
+	}
+
+	parts := make(map[string]string)
+	for i, name := range names {
+		if name != "" {
+			parts[name] = m[i+1]
+		}
+	}
+
+	idFormat := v.ID
+	if idFormat == "" {
+		if len(parts) != 1 {
+			return "", fmt.Errorf("resource ID format required for ARN resource format (%s)", v.Resource)
+		}
+		for name := range parts {
+			idFormat = fmt.Sprintf("{%s}", name)
+		}
+	}
+
+	var errs []string
+	id := placeholderRegex.ReplaceAllStringFunc(idFormat, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		v, ok := parts[name]
+		if !ok {
+			errs = append(errs, name)
+		}
+		return v
+	})
+
+	if len(errs) > 0 {
+		return "", fmt.Errorf("resource ID format (%s) references unknown placeholders: %s", idFormat, strings.Join(errs, ", "))
+	}
+
+	return id, nil
+}
+
+// resourceFormatRegex returns an expression matching ARN resource parts in the specified format
+// and the names of the expression's capture groups in order.
+// Placeholders match a single path segment except for the last, which matches the remainder of the resource part.
+func resourceFormatRegex(format string) (*regexp.Regexp, []string, error) {
+	matches := placeholderRegex.FindAllStringSubmatchIndex(format, -1)
+
+	if len(matches) == 0 {
+		return nil, nil, fmt.Errorf("ARN resource format (%s) contains no placeholders", format)
+	}
+
+	var sb strings.Builder
+	var names []string
+	start := 0
+
+	sb.WriteString("^")
+	for i, m := range matches {
+		sb.WriteString(regexp.QuoteMeta(format[start:m[0]]))
+		if i == len(matches)-1 {
+			sb.WriteString("(.+)")
+		} else {
+			sb.WriteString("([^/]+)")
+		}
+		names = append(names, format[m[2]:m[3]])
+		start = m[1]
+	}
+	sb.WriteString(regexp.QuoteMeta(format[start:]))
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return re, names, nil
+}
+
+// SDKStateContext returns a Plugin SDK import function that accepts the resource's ARN as well as its ID.
+// An ARN is converted to the resource's ID before the resource's own import function, if any, is called.
+// If regional is true the resource has a per-resource `region` attribute which is set from the ARN's Region.
+func SDKStateContext(v *types.ServicePackageResourceImportByARN, regional bool, f schema.StateContextFunc) schema.StateContextFunc {
+	if f == nil {
+		f = schema.ImportStatePassthroughContext
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id := d.Id(); arn.IsARN(id) {
+			if regional {
+				if arn, err := arn.Parse(id); err == nil && arn.Region != "" {
+					if err := d.Set(names.AttrRegion, arn.Region); err != nil {
+						return nil, fmt.Errorf("importing by ARN: setting %s: %w", names.AttrRegion, err)
+					}
+					ctx = conns.NewRegionalContext(ctx, arn.Region)
+				}
+			}
+
+			client, _ := meta.(*conns.AWSClient)
+			id, err := ResourceIDFromARN(ctx, client, v, id)
+
+			if err != nil {
+				return nil, fmt.Errorf("importing by ARN: %w", err)
+			}
+
+			d.SetId(id)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
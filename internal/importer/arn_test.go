@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestResourceIDFromARN(t *testing.T) {
+	t.Parallel()
+
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := context.Background()
+	testCases := []struct {
+		name        string
+		context     context.Context
+		importByARN *types.ServicePackageResourceImportByARN
+		arn         string
+		expectedID  string
+		expectError bool
+	}{
+		{
+			name: "single placeholder",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:        "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-cluster",
+		},
+		{
+			name: "multiple placeholders",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "addon/{cluster_name}/{addon_name}/{}",
+				ID:       "{cluster_name}:{addon_name}",
+			},
+			arn:        "arn:aws:eks:us-west-2:123456789012:addon/my-cluster/vpc-cni/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-cluster:vpc-cni",
+		},
+		{
+			name: "not in format",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "addon/{cluster_name}/{addon_name}/{}",
+				ID:       "{cluster_name}:{addon_name}",
+			},
+			arn:         "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name: "wrong service",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:         "arn:aws:ecs:us-west-2:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name: "wrong Region",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:         "arn:aws:eks:us-east-1:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:    "per-resource Region",
+			context: conns.NewRegionalContext(ctx, "us-east-1"), //lintignore:AWSAT003
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:        "arn:aws:eks:us-east-1:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-cluster",
+		},
+		{
+			name:    "wrong per-resource Region",
+			context: conns.NewRegionalContext(ctx, "us-east-1"), //lintignore:AWSAT003
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:         "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name: "wrong account",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:         "arn:aws:eks:us-west-2:210987654321:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name: "unknown ID placeholder",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+				ID:       "{cluster_name}",
+			},
+			arn:         "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name: "invalid ARN",
+			importByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
+			arn:         "my-cluster",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.context
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			got, err := ResourceIDFromARN(ctx, client, testCase.importByARN, testCase.arn)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.expectedID {
+				t.Errorf("got %s, expected %s", got, testCase.expectedID)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AllOperations can be passed as the operation name to RegisterSensitiveFields
+// to mask a field in the requests and responses of every operation of a service.
+const AllOperations = ""
+
+// sensitiveFieldsRegistry holds the names of AWS API request and response fields whose values
+// must never appear in logs, keyed by AWS SDK service ID and then by operation name.
+type sensitiveFieldsRegistry struct {
+	lock    sync.RWMutex
+	fields  map[string]map[string][]string
+	regexes map[string]*regexp.Regexp // Cache of compiled expressions, keyed by service ID and operation name.
+}
+
+var sensitiveFields = newSensitiveFieldsRegistry()
+
+func newSensitiveFieldsRegistry() *sensitiveFieldsRegistry {
+	r := &sensitiveFieldsRegistry{
+		fields:  make(map[string]map[string][]string),
+		regexes: make(map[string]*regexp.Regexp),
+	}
+
+	// Well-known secrets.
+	// The service IDs are those used by both AWS SDK for Go v1 and v2, e.g. `r.ClientInfo.ServiceID` and `middleware.GetServiceID`.
+	r.register("Directory Service", AllOperations, "Password", "NewPassword")
+	r.register("DocDB", AllOperations, "MasterUserPassword")
+	r.register("IAM", "CreateAccessKey", "SecretAccessKey")
+	r.register("IAM", "CreateLoginProfile", "Password")
+	r.register("IAM", "CreateServiceSpecificCredential", "ServicePassword")
+	r.register("IAM", "ResetServiceSpecificCredential", "ServicePassword")
+	r.register("IAM", "UpdateLoginProfile", "Password")
+	r.register("Neptune", AllOperations, "MasterUserPassword")
+	r.register("RDS", AllOperations, "MasterUserPassword")
+	r.register("Redshift", AllOperations, "MasterUserPassword")
+	r.register("Secrets Manager", AllOperations, "SecretBinary", "SecretString")
+	r.register("SSM", "GetParameter", "Value")
+	r.register("SSM", "GetParameterHistory", "Value")
+	r.register("SSM", "GetParameters", "Value")
+	r.register("SSM", "GetParametersByPath", "Value")
+	r.register("SSM", "PutParameter", "Value")
+
+	return r
+}
+
+func (r *sensitiveFieldsRegistry) register(serviceID, operation string, fields ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	operations, ok := r.fields[serviceID]
+	if !ok {
+		operations = make(map[string][]string)
+		r.fields[serviceID] = operations
+	}
+
+	operations[operation] = append(operations[operation], fields...)
+
+	// Invalidate any cached expressions for the service.
+	for k := range r.regexes {
+		if strings.HasPrefix(k, registryKey(serviceID, "")) {
+			delete(r.regexes, k)
+		}
+	}
+}
+
+// lookup returns the sorted, de-duplicated names of the sensitive fields for the specified service operation.
+func (r *sensitiveFieldsRegistry) lookup(serviceID, operation string) []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.lookupNoLock(serviceID, operation)
+}
+
+// lookupNoLock is lookup for callers already holding the registry lock.
+func (r *sensitiveFieldsRegistry) lookupNoLock(serviceID, operation string) []string {
+	operations, ok := r.fields[serviceID]
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var result []string
+	for _, op := range []string{AllOperations, operation} {
+		for _, field := range operations[op] {
+			if _, ok := seen[field]; ok {
+				continue
+			}
+			seen[field] = struct{}{}
+			result = append(result, field)
+		}
+		if operation == AllOperations {
+			break
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// regex returns the compiled expression matching the sensitive fields for the specified service operation,
+// or nil if the operation has no sensitive fields.
+func (r *sensitiveFieldsRegistry) regex(serviceID, operation string) *regexp.Regexp {
+	key := registryKey(serviceID, operation)
+
+	r.lock.RLock()
+	re, ok := r.regexes[key]
+	r.lock.RUnlock()
+
+	if ok {
+		return re
+	}
+
+	// Compute and store under the write lock so that a concurrent register cannot invalidate
+	// the cache between the lookup of the fields and the store of the expression built from them.
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if re, ok := r.regexes[key]; ok {
+		return re
+	}
+
+	if fields := r.lookupNoLock(serviceID, operation); len(fields) > 0 {
+		re = sensitiveFieldsRegex(fields)
+	}
+
+	r.regexes[key] = re
+
+	return re
+}
+
+func registryKey(serviceID, operation string) string {
+	return fmt.Sprintf("%s/%s", serviceID, operation)
+}
+
+// sensitiveFieldsRegex returns an expression matching the specified fields and their values
+// in JSON, XML and query-string (form-encoded) AWS API request and response bodies.
+func sensitiveFieldsRegex(fields []string) *regexp.Regexp {
+	alternation := make([]string, len(fields))
+	for i, field := range fields {
+		alternation[i] = regexp.QuoteMeta(field)
+	}
+	names := strings.Join(alternation, "|")
+
+	return regexp.MustCompile(strings.Join([]string{
+		fmt.Sprintf(`"(?:%[1]s)"\s*:\s*"(?:[^"\\]|\\.)*"`, names), // JSON.
+		fmt.Sprintf(`<(?:%[1]s)>[^<]*</(?:%[1]s)>`, names),        // XML.
+		fmt.Sprintf(`\b(?:%[1]s)=[^&\s]*`, names),                 // Query string.
+	}, "|"))
+}
+
+// RegisterSensitiveFields registers the names of AWS API request and response fields
+// whose values are redacted from the provider's debug logs.
+// serviceID is the AWS SDK service ID, e.g. "Secrets Manager", and operation the API operation name, e.g. "GetSecretValue".
+// Use AllOperations to redact the fields for every operation of the service.
+// Resources with Sensitive attributes should register the corresponding API fields if they are not already covered.
+func RegisterSensitiveFields(serviceID, operation string, fields ...string) {
+	sensitiveFields.register(serviceID, operation, fields...)
+}
+
+// SensitiveFields returns the names of the fields that are redacted from the logs of the specified service operation.
+func SensitiveFields(serviceID, operation string) []string {
+	return sensitiveFields.lookup(serviceID, operation)
+}
+
+// MaskSensitiveFields returns a Context in which the values of any sensitive fields
+// registered for the specified service operation are masked in logged field values,
+// e.g. HTTP request and response bodies.
+func MaskSensitiveFields(ctx context.Context, serviceID, operation string) context.Context {
+	if re := sensitiveFields.regex(serviceID, operation); re != nil {
+		ctx = tflog.MaskAllFieldValuesRegexes(ctx, re)
+	}
+
+	return ctx
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		serviceID string
+		operation string
+		expected  []string
+	}{
+		{
+			name:      "unknown service",
+			serviceID: "Unknown",
+			operation: "GetThing",
+		},
+		{
+			name:      "operation specific",
+			serviceID: "SSM",
+			operation: "PutParameter",
+			expected:  []string{"Value"},
+		},
+		{
+			name:      "operation not registered",
+			serviceID: "SSM",
+			operation: "DescribeParameters",
+		},
+		{
+			name:      "all operations",
+			serviceID: "Secrets Manager",
+			operation: "GetSecretValue",
+			expected:  []string{"SecretBinary", "SecretString"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SensitiveFields(testCase.serviceID, testCase.operation)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRegisterSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	r := newSensitiveFieldsRegistry()
+
+	if re := r.regex("Test", "CreateThing"); re != nil {
+		t.Fatalf("expected no expression, got: %s", re)
+	}
+
+	r.register("Test", AllOperations, "Token")
+	r.register("Test", "CreateThing", "Password", "Token")
+
+	if got, want := r.lookup("Test", "CreateThing"), []string{"Password", "Token"}; !cmp.Equal(got, want) {
+		t.Errorf("got: %v, expected: %v", got, want)
+	}
+
+	if got, want := r.lookup("Test", "DeleteThing"), []string{"Token"}; !cmp.Equal(got, want) {
+		t.Errorf("got: %v, expected: %v", got, want)
+	}
+
+	if re := r.regex("Test", "CreateThing"); re == nil {
+		t.Fatal("expected expression, got none")
+	}
+}
+
+func TestRegisterSensitiveFieldsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	r := newSensitiveFieldsRegistry()
+	fields := []string{"Password", "Secret", "Token"}
+
+	var wg sync.WaitGroup
+	for _, field := range fields {
+		field := field
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			r.register("Test", AllOperations, field)
+		}()
+		go func() {
+			defer wg.Done()
+			r.regex("Test", "CreateThing")
+		}()
+	}
+	wg.Wait()
+
+	re := r.regex("Test", "CreateThing")
+	if re == nil {
+		t.Fatal("expected expression, got none")
+	}
+
+	for _, field := range fields {
+		if body := fmt.Sprintf(`{"%s":"s3cr3t"}`, field); !re.MatchString(body) {
+			t.Errorf("expected expression %s to match %s", re, body)
+		}
+	}
+}
+
+func TestMaskSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		serviceID string
+		operation string
+		body      string
+		secret    string
+		expected  string
+	}{
+		{
+			name:      "JSON",
+			serviceID: "Secrets Manager",
+			operation: "GetSecretValue",
+			body:      `{"ARN":"arn","SecretString":"s3cr3t \"quoted\"","VersionId":"v1"}`,
+			secret:    "s3cr3t",
+			expected:  `{"ARN":"arn",***,"VersionId":"v1"}`,
+		},
+		{
+			name:      "query string",
+			serviceID: "RDS",
+			operation: "CreateDBInstance",
+			body:      "Action=CreateDBInstance&MasterUserPassword=s3cr3t&MasterUsername=admin",
+			secret:    "s3cr3t",
+			expected:  "Action=CreateDBInstance&***&MasterUsername=admin",
+		},
+		{
+			name:      "XML",
+			serviceID: "Redshift",
+			operation: "ModifyCluster",
+			body:      "<ModifyClusterResult><MasterUserPassword>s3cr3t</MasterUserPassword></ModifyClusterResult>",
+			secret:    "s3cr3t",
+			expected:  "<ModifyClusterResult>***</ModifyClusterResult>",
+		},
+		{
+			name:      "not sensitive",
+			serviceID: "SSM",
+			operation: "DescribeParameters",
+			body:      `{"Value":"visible"}`,
+			expected:  `{"Value":"visible"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &buf)
+			ctx = MaskSensitiveFields(ctx, testCase.serviceID, testCase.operation)
+
+			tflog.Debug(ctx, "HTTP Request Sent", map[string]any{
+				"http.request.body": testCase.body,
+			})
+
+			entries, err := tflogtest.MultilineJSONDecode(&buf)
+			if err != nil {
+				t.Fatalf("decoding log entries: %s", err)
+			}
+
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 log entry, got %d", len(entries))
+			}
+
+			got := entries[0]["http.request.body"].(string)
+
+			if testCase.secret != "" && strings.Contains(got, testCase.secret) {
+				t.Errorf("secret %q found in logged body: %s", testCase.secret, got)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got: %s, expected: %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type driftContextKeyType int
+
+var driftContextKey driftContextKeyType
+
+// driftResourceInterceptor reports changes made outside of Terraform to a resource's configurable attributes.
+// It is a no-op unless drift diagnostics are enabled in the provider configuration.
+type driftResourceInterceptor struct {
+	attributes       []string                                 // Top-level configurable, non-Computed attributes.
+	diffSuppressFunc map[string]schema.SchemaDiffSuppressFunc // Top-level attributes with semantic equality.
+	hasARN           bool
+}
+
+func newDriftResourceInterceptor(s map[string]*schema.Schema) driftResourceInterceptor {
+	r := driftResourceInterceptor{
+		diffSuppressFunc: make(map[string]schema.SchemaDiffSuppressFunc),
+	}
+
+	for k, v := range s {
+		if k == names.AttrARN {
+			r.hasARN = true
+		}
+
+		// Computed attributes, including Optional+Computed ones, may legitimately be set by AWS when not configured.
+		// tags_all is derived from tags and the per-resource Region forces replacement rather than drifting.
+		if !v.Optional && !v.Required || v.Computed || k == names.AttrTagsAll || k == names.AttrRegion {
+			continue
+		}
+
+		r.attributes = append(r.attributes, k)
+
+		if v.Type == schema.TypeString && v.DiffSuppressFunc != nil {
+			r.diffSuppressFunc[k] = v.DiffSuppressFunc
+		}
+	}
+
+	sort.Strings(r.attributes)
+
+	return r
+}
+
+func (r driftResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok || client.DriftDiagnosticsConfig == nil || why != Read {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Before the Read handler has run, d contains the prior state.
+		state := d.GetRawState()
+		if state.IsNull() || !state.IsKnown() {
+			return ctx, diags
+		}
+
+		prior := make(map[string]any)
+		for _, k := range r.attributes {
+			// Attributes that have never been set, e.g. just after import, cannot have drifted.
+			if !state.Type().HasAttribute(k) || state.GetAttr(k).IsNull() {
+				continue
+			}
+			prior[k] = d.Get(k)
+		}
+
+		ctx = context.WithValue(ctx, driftContextKey, prior)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		prior, ok := ctx.Value(driftContextKey).(map[string]any)
+		if !ok {
+			return ctx, diags
+		}
+
+		var changed []string
+		for _, k := range r.attributes {
+			o, ok := prior[k]
+			if !ok {
+				continue
+			}
+
+			n := d.Get(k)
+
+			if f, ok := r.diffSuppressFunc[k]; ok {
+				if rd, ok := d.(*schema.ResourceData); ok && f(k, o.(string), n.(string), rd) {
+					continue
+				}
+			}
+
+			if !driftValuesEqual(o, n) {
+				changed = append(changed, fmt.Sprintf("`%s`", k))
+			}
+		}
+
+		if len(changed) == 0 {
+			return ctx, diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+		if err != nil {
+			serviceName = "<service>"
+		}
+
+		resourceName := inContext.ResourceName
+		if resourceName == "" {
+			resourceName = "<thing>"
+		}
+
+		detail := fmt.Sprintf("The following attributes were changed outside of Terraform since the last refresh: %s.", strings.Join(changed, ", "))
+
+		if client.DriftDiagnosticsConfig.CloudTrailLookup {
+			identifiers := []string{d.Id()}
+			if r.hasARN {
+				if v, ok := d.Get(names.AttrARN).(string); ok && v != "" && v != d.Id() {
+					identifiers = append([]string{v}, identifiers...)
+				}
+			}
+
+			event, err := tfcloudtrail.FindLatestWriteEventByResourceNames(ctx, client.CloudTrailConn(ctx), identifiers)
+
+			switch {
+			case err != nil:
+				tflog.Warn(ctx, "looking up CloudTrail events", map[string]any{
+					"error": err.Error(),
+				})
+			case event != nil:
+				detail += fmt.Sprintf("\n\nThe most recent change recorded in CloudTrail was %s by %s at %s.",
+					aws.StringValue(event.EventName), aws.StringValue(event.Username), aws.TimeValue(event.EventTime).Format(time.RFC3339))
+			}
+		}
+
+		diags = append(diags, errs.NewWarningDiagnostic(
+			fmt.Sprintf("%s %s (%s) changed outside of Terraform", serviceName, resourceName, d.Id()),
+			detail,
+		))
+	}
+
+	return ctx, diags
+}
+
+// driftValuesEqual returns whether two values returned from schema.ResourceData.Get are equal.
+func driftValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case *schema.Set:
+		b, ok := b.(*schema.Set)
+		if !ok {
+			return false
+		}
+		// Set element identity is determined by hash code.
+		return a.Difference(b).Len() == 0 && b.Difference(a).Len() == 0
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !driftValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !driftValuesEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestDriftValuesEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		a        any
+		b        any
+		expected bool
+	}{
+		{
+			name:     "equal strings",
+			a:        "value",
+			b:        "value",
+			expected: true,
+		},
+		{
+			name: "different strings",
+			a:    "value1",
+			b:    "value2",
+		},
+		{
+			name:     "equal sets",
+			a:        schema.NewSet(schema.HashString, []any{"a", "b"}),
+			b:        schema.NewSet(schema.HashString, []any{"b", "a"}),
+			expected: true,
+		},
+		{
+			name: "different sets",
+			a:    schema.NewSet(schema.HashString, []any{"a", "b"}),
+			b:    schema.NewSet(schema.HashString, []any{"a"}),
+		},
+		{
+			name: "nested sets",
+			a: []any{map[string]any{
+				"values": schema.NewSet(schema.HashString, []any{"a", "b"}),
+			}},
+			b: []any{map[string]any{
+				"values": schema.NewSet(schema.HashString, []any{"b", "a"}),
+			}},
+			expected: true,
+		},
+		{
+			name: "different list lengths",
+			a:    []any{"a"},
+			b:    []any{"a", "b"},
+		},
+		{
+			name: "different map keys",
+			a:    map[string]any{"key1": "value"},
+			b:    map[string]any{"key2": "value"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := driftValuesEqual(testCase.a, testCase.b), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDriftResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		config       *conns.DriftDiagnosticsConfig
+		refreshed    map[string]string
+		expectedWarn string
+	}{
+		{
+			name:   "no drift",
+			config: &conns.DriftDiagnosticsConfig{},
+			refreshed: map[string]string{
+				"status": "UPDATING",
+			},
+		},
+		{
+			name:   "Optional+Computed",
+			config: &conns.DriftDiagnosticsConfig{},
+			refreshed: map[string]string{
+				"kms_key_id": "changed",
+				"region":     "eu-west-1", //lintignore:AWSAT003
+			},
+		},
+		{
+			name:   "drift",
+			config: &conns.DriftDiagnosticsConfig{},
+			refreshed: map[string]string{
+				"description": "changed",
+				"name":        "changed",
+			},
+			expectedWarn: "`description`, `name`",
+		},
+		{
+			name: "disabled",
+			refreshed: map[string]string{
+				"name": "changed",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := resource.Data(&terraform.InstanceState{
+				ID: "test-id",
+				Attributes: map[string]string{
+					"arn":         "arn:aws:test:us-west-2:123456789012:thing/test-id", //lintignore:AWSAT003,AWSAT005
+					"description": "original",
+					"id":          "test-id",
+					"kms_key_id":  "original",
+					"name":        "original",
+					"region":      "us-west-2", //lintignore:AWSAT003
+					"status":      "AVAILABLE",
+				},
+				RawState: cty.ObjectVal(map[string]cty.Value{
+					"arn":         cty.StringVal("arn:aws:test:us-west-2:123456789012:thing/test-id"), //lintignore:AWSAT003,AWSAT005
+					"description": cty.StringVal("original"),
+					"id":          cty.StringVal("test-id"),
+					"kms_key_id":  cty.StringVal("original"),
+					"name":        cty.StringVal("original"),
+					"region":      cty.StringVal("us-west-2"), //lintignore:AWSAT003
+					"status":      cty.StringVal("AVAILABLE"),
+				}),
+			})
+			interceptor := newDriftResourceInterceptor(resource.SchemaMap())
+			meta := &conns.AWSClient{
+				DriftDiagnosticsConfig: testCase.config,
+			}
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Thing")
+
+			var diags diag.Diagnostics
+			ctx, diags = interceptor.run(ctx, d, meta, Before, Read, diags)
+
+			for k, v := range testCase.refreshed {
+				if err := d.Set(k, v); err != nil {
+					t.Fatalf("setting %s: %s", k, err)
+				}
+			}
+
+			_, diags = interceptor.run(ctx, d, meta, After, Read, diags)
+
+			if testCase.expectedWarn == "" {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got: %v", diags)
+			}
+
+			if got, want := diags[0].Severity, diag.Warning; got != want {
+				t.Errorf("severity = %v, want %v", got, want)
+			}
+
+			if !strings.Contains(diags[0].Detail, testCase.expectedWarn) {
+				t.Errorf("detail %q does not contain %q", diags[0].Detail, testCase.expectedWarn)
+			}
+		})
+	}
+}
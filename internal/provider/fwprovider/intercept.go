@@ -6,15 +6,25 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
+	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -194,14 +204,16 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
+	importByARN      *types.ServicePackageResourceImportByARN
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, importByARN *types.ServicePackageResourceImportByARN) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		importByARN:      importByARN,
 		inner:            inner,
 		interceptors:     interceptors,
 	}
@@ -268,6 +280,20 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		// The resource has opted in to import by ARN.
+		if w.importByARN != nil && arn.IsARN(request.ID) {
+			id, err := importer.ResourceIDFromARN(ctx, w.meta, w.importByARN, request.ID)
+
+			if err != nil {
+				response.Diagnostics.AddError("Importing Resource By ARN", err.Error())
+
+				return
+			}
+
+			request.ID = id
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -577,3 +603,176 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+type driftContextKeyType int
+
+var driftContextKey driftContextKeyType
+
+// driftResourceInterceptor reports changes made outside of Terraform to a resource's configurable attributes.
+// It is a no-op unless drift diagnostics are enabled in the provider configuration.
+type driftResourceInterceptor struct {
+	attributes []string // Top-level configurable, non-Computed attributes and blocks.
+	hasARN     bool
+}
+
+func newDriftResourceInterceptor(s schema.Schema) driftResourceInterceptor {
+	var r driftResourceInterceptor
+
+	for k, v := range s.Attributes {
+		if k == names.AttrARN {
+			r.hasARN = true
+		}
+
+		// Computed attributes, including Optional+Computed ones, may legitimately be set by AWS when not configured.
+		// tags_all is derived from tags.
+		if !v.IsOptional() && !v.IsRequired() || v.IsComputed() || k == names.AttrTagsAll {
+			continue
+		}
+
+		r.attributes = append(r.attributes, k)
+	}
+
+	for k := range s.Blocks {
+		r.attributes = append(r.attributes, k)
+	}
+
+	sort.Strings(r.attributes)
+
+	return r
+}
+
+func (r driftResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.DriftDiagnosticsConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Before the Read handler has run, the request contains the prior state.
+		prior := topLevelValues(request.State.Raw)
+		if prior == nil {
+			return ctx, diags
+		}
+
+		ctx = context.WithValue(ctx, driftContextKey, prior)
+	case After:
+		prior, ok := ctx.Value(driftContextKey).(map[string]tftypes.Value)
+		if !ok {
+			return ctx, diags
+		}
+
+		// Will occur on a refresh when the resource does not exist in AWS.
+		current := topLevelValues(response.State.Raw)
+		if current == nil {
+			return ctx, diags
+		}
+
+		var changed []string
+		for _, k := range r.attributes {
+			o, ok := prior[k]
+			// Attributes that have never been set, e.g. just after import, cannot have drifted.
+			if !ok || o.IsNull() {
+				continue
+			}
+
+			if n, ok := current[k]; ok && !n.Equal(o) {
+				changed = append(changed, fmt.Sprintf("`%s`", k))
+			}
+		}
+
+		if len(changed) == 0 {
+			return ctx, diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+		if err != nil {
+			serviceName = "<service>"
+		}
+
+		resourceName := inContext.ResourceName
+		if resourceName == "" {
+			resourceName = "<thing>"
+		}
+
+		var identifiers []string
+		if r.hasARN {
+			if v := stringValue(current[names.AttrARN]); v != "" {
+				identifiers = append(identifiers, v)
+			}
+		}
+		id := stringValue(current[names.AttrID])
+		if id != "" && (len(identifiers) == 0 || identifiers[0] != id) {
+			identifiers = append(identifiers, id)
+		}
+		if id == "" && len(identifiers) > 0 {
+			id = identifiers[0]
+		}
+
+		detail := fmt.Sprintf("The following attributes were changed outside of Terraform since the last refresh: %s.", strings.Join(changed, ", "))
+
+		if meta.DriftDiagnosticsConfig.CloudTrailLookup && len(identifiers) > 0 {
+			event, err := tfcloudtrail.FindLatestWriteEventByResourceNames(ctx, meta.CloudTrailConn(ctx), identifiers)
+
+			switch {
+			case err != nil:
+				tflog.Warn(ctx, "looking up CloudTrail events", map[string]any{
+					"error": err.Error(),
+				})
+			case event != nil:
+				detail += fmt.Sprintf("\n\nThe most recent change recorded in CloudTrail was %s by %s at %s.",
+					aws.StringValue(event.EventName), aws.StringValue(event.Username), aws.TimeValue(event.EventTime).Format(time.RFC3339))
+			}
+		}
+
+		diags.AddWarning(fmt.Sprintf("%s %s (%s) changed outside of Terraform", serviceName, resourceName, id), detail)
+	}
+
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// topLevelValues returns the top-level attribute and block values of the specified state object.
+// nil is returned if the state is null, unknown or not an object.
+func topLevelValues(v tftypes.Value) map[string]tftypes.Value {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return nil
+	}
+
+	return m
+}
+
+// stringValue returns the specified value as a string.
+// An empty string is returned if the value is null, unknown or not a string.
+func stringValue(v tftypes.Value) string {
+	if v.IsNull() || !v.IsKnown() || !v.Type().Is(tftypes.String) {
+		return ""
+	}
+
+	var s string
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
@@ -63,6 +63,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoints_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a JSON file containing service endpoints, either in the AWS partitions (`endpoints.json`) format or a map of service names to endpoint URLs. Endpoints set in the `endpoints` configuration block take precedence.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -258,6 +262,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"drift_diagnostics": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to report resource changes made outside of Terraform as warnings.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloudtrail_lookup": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Look up who made the most recent change to a drifted resource in CloudTrail event history.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -403,14 +421,18 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// Plugin Framework resources do not have a per-resource `region` attribute:
+			// their models are decoded from the full schema, so an injected attribute would not round-trip.
+			interceptors := resourceInterceptors{
+				newDriftResourceInterceptor(schemaResponse.Schema),
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -434,7 +456,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.ImportByARN)
 			})
 		}
 	}
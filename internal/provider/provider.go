@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -73,6 +74,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"drift_diagnostics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to report resource changes made outside of Terraform as warnings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudtrail_lookup": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Look up who made the most recent change to a drifted resource in CloudTrail event history.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -86,6 +102,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoints_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a JSON file containing service endpoints, either in the AWS partitions (`endpoints.json`) format " +
+					"or a map of service names to endpoint URLs. Endpoints set in the `endpoints` configuration block take precedence.",
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -292,6 +314,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if !names.IsGlobal(servicePackageName) && addRegionAttribute(r, true) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,6 +399,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// The per-resource Region must be in Context before any other interceptors run.
+			regional := !names.IsGlobal(servicePackageName) && addRegionAttribute(r, false)
+			if regional {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | After,
+				why:         Read,
+				interceptor: newDriftResourceInterceptor(r.SchemaMap()),
+			})
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -421,6 +467,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.DeleteWithoutTimeout; v != nil {
 				r.DeleteWithoutTimeout = rs.Delete(v)
 			}
+			if importByARN := v.ImportByARN; importByARN != nil && r.Importer != nil && r.Importer.State == nil {
+				// The resource has opted in to import by ARN.
+				r.Importer.StateContext = importer.SDKStateContext(importByARN, regional, r.Importer.StateContext)
+			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
 					r.Importer.StateContext = rs.State(v)
@@ -533,6 +583,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("drift_diagnostics"); ok && len(v.([]interface{})) > 0 {
+		config.DriftDiagnosticsConfig = expandDriftDiagnostics(ctx, v.([]interface{})[0])
+	}
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
 
@@ -543,6 +597,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.Endpoints = endpoints
 	}
 
+	if v, ok := d.GetOk("endpoints_file"); ok {
+		config.EndpointsFile = v.(string)
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -869,6 +927,22 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandDriftDiagnostics(_ context.Context, tfMapRaw interface{}) *conns.DriftDiagnosticsConfig {
+	// An empty block enables drift diagnostics with default settings.
+	config := &conns.DriftDiagnosticsConfig{}
+
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+	if !ok {
+		return config
+	}
+
+	if v, ok := tfMap["cloudtrail_lookup"].(bool); ok {
+		config.CloudTrailLookup = v
+	}
+
+	return config
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -5,10 +5,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,6 +32,41 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestImportByARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	placeholder := regexp.MustCompile(`\{([0-9A-Za-z_]*)\}`)
+
+	for _, sp := range servicePackages(ctx) {
+		var resources []*types.ServicePackageResourceImportByARN
+		for _, v := range sp.SDKResources(ctx) {
+			resources = append(resources, v.ImportByARN)
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			resources = append(resources, v.ImportByARN)
+		}
+
+		for _, v := range resources {
+			if v == nil {
+				continue
+			}
+
+			// Build an ARN with a distinct value for each placeholder.
+			n := 0
+			resource := placeholder.ReplaceAllStringFunc(v.Resource, func(string) string {
+				n++
+				return fmt.Sprintf("value%d", n)
+			})
+			arn := fmt.Sprintf("arn:aws:%s:us-west-2:123456789012:%s", v.Service, resource) //lintignore:AWSAT003,AWSAT005
+
+			if _, err := importer.ResourceIDFromARN(ctx, nil, v, arn); err != nil {
+				t.Errorf("%s: %s", sp.ServicePackageName(), err)
+			}
+		}
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// addRegionAttribute adds the per-resource `region` attribute to the specified resource or data source schema.
+// It returns false if the schema already defines a top-level `region` attribute.
+func addRegionAttribute(r *schema.Resource, isDataSource bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	attr := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     !isDataSource,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = attr
+			return s
+		}
+	} else {
+		r.Schema[names.AttrRegion] = attr
+	}
+
+	return true
+}
+
+// regionInterceptor routes a resource's or data source's AWS API calls to its per-resource Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Configured value on Create, state value otherwise.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			ctx = conns.NewRegionalContext(ctx, v)
+		}
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		switch why {
+		case Create, Read:
+			if err := d.Set(names.AttrRegion, client.RegionFromContext(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
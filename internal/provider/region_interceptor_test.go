@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAddRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		resource     *schema.Resource
+		isDataSource bool
+		expected     bool
+		forceNew     bool
+	}{
+		{
+			name: "resource",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			expected: true,
+			forceNew: true,
+		},
+		{
+			name: "data source",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			isDataSource: true,
+			expected:     true,
+		},
+		{
+			name: "schema function",
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					}
+				},
+			},
+			expected: true,
+			forceNew: true,
+		},
+		{
+			name: "existing attribute",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrRegion: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := addRegionAttribute(testCase.resource, testCase.isDataSource), testCase.expected; got != want {
+				t.Fatalf("got: %t, expected: %t", got, want)
+			}
+
+			v, ok := testCase.resource.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatal("no region attribute")
+			}
+
+			if !testCase.expected {
+				return
+			}
+
+			if !v.Optional || !v.Computed {
+				t.Errorf("region attribute must be Optional and Computed")
+			}
+
+			if got, want := v.ForceNew, testCase.forceNew; got != want {
+				t.Errorf("ForceNew = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	addRegionAttribute(resource, false)
+
+	testCases := []struct {
+		name     string
+		region   string
+		expected string
+	}{
+		{
+			name:     "provider Region",
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:     "per-resource Region",
+			region:   "eu-west-1", //lintignore:AWSAT003
+			expected: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := map[string]string{
+				"id":           "test-id",
+				names.AttrName: "test",
+			}
+			if testCase.region != "" {
+				attributes[names.AttrRegion] = testCase.region
+			}
+			d := resource.Data(&terraform.InstanceState{
+				ID:         "test-id",
+				Attributes: attributes,
+			})
+			meta := &conns.AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			}
+			ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+
+			var diags diag.Diagnostics
+			ctx, diags = regionInterceptor{}.run(ctx, d, meta, Before, Read, diags)
+
+			if got, want := meta.RegionFromContext(ctx), testCase.expected; got != want {
+				t.Errorf("Region in Context = %s, want %s", got, want)
+			}
+
+			_, diags = regionInterceptor{}.run(ctx, d, meta, After, Read, diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expected; got != want {
+				t.Errorf("region = %s, want %s", got, want)
+			}
+		})
+	}
+}
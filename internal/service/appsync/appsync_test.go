@@ -67,6 +67,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"AdditionalAuthentication_multiple":                   testAccGraphQLAPI_AdditionalAuthentication_multiple,
 			"xrayEnabled":                                         testAccGraphQLAPI_xrayEnabled,
 			"visibility":                                          testAccGraphQLAPI_visibility,
+			"mergedAPI":                                           testAccGraphQLAPI_mergedAPI,
 		},
 		"Function": {
 			"basic":                   testAccFunction_basic,
@@ -102,6 +103,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"disappears":  testAccDomainName_disappears,
 			"description": testAccDomainName_description,
 		},
+		"SourceAPIAssociation": {
+			"basic":       testAccSourceAPIAssociation_basic,
+			"disappears":  testAccSourceAPIAssociation_disappears,
+			"description": testAccSourceAPIAssociation_description,
+		},
 		"DomainNameAssociation": {
 			"basic":      testAccDomainNameAPIAssociation_basic,
 			"disappears": testAccDomainNameAPIAssociation_disappears,
@@ -127,6 +127,13 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"api_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appsync.GraphQLApiTypeGraphql,
+				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiType_Values(), false),
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,6 +190,11 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"merged_api_execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -286,7 +298,11 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("additional_authentication_provider"); ok {
-		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(d))
+	}
+
+	if v, ok := d.GetOk("api_type"); ok {
+		input.ApiType = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -297,12 +313,16 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 		input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("openid_connect_config"); ok {
 		input.OpenIDConnectConfig = expandGraphQLAPIOpenIDConnectConfig(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("user_pool_config"); ok {
-		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(d))
 	}
 
 	if v, ok := d.GetOk("xray_enabled"); ok {
@@ -349,6 +369,7 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	if err := d.Set("additional_authentication_provider", flattenGraphQLAPIAdditionalAuthenticationProviders(api.AdditionalAuthenticationProviders)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_authentication_provider: %s", err)
 	}
+	d.Set("api_type", api.ApiType)
 	d.Set("arn", api.Arn)
 	d.Set("authentication_type", api.AuthenticationType)
 	if err := d.Set("lambda_authorizer_config", flattenGraphQLAPILambdaAuthorizerConfig(api.LambdaAuthorizerConfig)); err != nil {
@@ -357,6 +378,7 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	if err := d.Set("log_config", flattenGraphQLAPILogConfig(api.LogConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting log_config: %s", err)
 	}
+	d.Set("merged_api_execution_role_arn", api.MergedApiExecutionRoleArn)
 	if err := d.Set("openid_connect_config", flattenGraphQLAPIOpenIDConnectConfig(api.OpenIDConnectConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting openid_connect_config: %s", err)
 	}
@@ -387,7 +409,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("additional_authentication_provider"); ok {
-			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(d))
 		}
 
 		if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -398,12 +420,16 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 		}
 
+		if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+			input.MergedApiExecutionRoleArn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("openid_connect_config"); ok {
 			input.OpenIDConnectConfig = expandGraphQLAPIOpenIDConnectConfig(v.([]interface{}))
 		}
 
 		if v, ok := d.GetOk("user_pool_config"); ok {
-			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(d))
 		}
 
 		if v, ok := d.GetOk("xray_enabled"); ok {
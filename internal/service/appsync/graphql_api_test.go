@@ -1208,6 +1208,36 @@ func testAccGraphQLAPI_visibility(t *testing.T) {
 	})
 }
 
+func testAccGraphQLAPI_mergedAPI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var api1 appsync.GraphqlApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_graphql_api.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphQLAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphQLAPIConfig_mergedAPI(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "api_type", "MERGED"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_execution_role_arn", roleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGraphQLAPIDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
@@ -1291,6 +1321,57 @@ resource "aws_appsync_graphql_api" "test" {
 `, rName, visibility)
 }
 
+func testAccGraphQLAPIConfig_mergedAPIBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appsync.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "appsync:SourceGraphQL",
+        "appsync:StartSchemaMerge",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccGraphQLAPIConfig_mergedAPI(rName string) string {
+	return acctest.ConfigCompose(testAccGraphQLAPIConfig_mergedAPIBase(rName), fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  api_type                      = "MERGED"
+  authentication_type           = "API_KEY"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+  name                          = %[1]q
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
 func testAccGraphQLAPIConfig_logFieldLogLevel(rName, fieldLogLevel string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
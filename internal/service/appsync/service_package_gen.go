@@ -65,6 +65,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceResolver,
 			TypeName: "aws_appsync_resolver",
 		},
+		{
+			Factory:  ResourceSourceAPIAssociation,
+			TypeName: "aws_appsync_source_api_association",
+			Name:     "Source API Association",
+		},
 		{
 			Factory:  ResourceType,
 			TypeName: "aws_appsync_type",
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_appsync_source_api_association", name="Source API Association")
+func ResourceSourceAPIAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSourceAPIAssociationCreate,
+		ReadWithoutTimeout:   resourceSourceAPIAssociationRead,
+		UpdateWithoutTimeout: resourceSourceAPIAssociationUpdate,
+		DeleteWithoutTimeout: resourceSourceAPIAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"merged_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(appsync.MergeType_Values(), false),
+						},
+					},
+				},
+			},
+			"source_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const sourceAPIAssociationResourceIDPartCount = 2
+
+func resourceSourceAPIAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	mergedAPIID := d.Get("merged_api_id").(string)
+	sourceAPIID := d.Get("source_api_id").(string)
+	input := &appsync.AssociateSourceGraphqlApiInput{
+		MergedApiIdentifier: aws.String(mergedAPIID),
+		SourceApiIdentifier: aws.String(sourceAPIID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.AssociateSourceGraphqlApiWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Source API Association (%s/%s): %s", mergedAPIID, sourceAPIID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{mergedAPIID, aws.StringValue(output.SourceApiAssociation.AssociationId)}, sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitSourceAPIAssociationMerged(ctx, conn, mergedAPIID, aws.StringValue(output.SourceApiAssociation.AssociationId), sourceAPIAssociationMergeType(output.SourceApiAssociation), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+	association, err := FindSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("description", association.Description)
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", association.MergedApiId)
+	d.Set("source_api_arn", association.SourceApiArn)
+	if err := d.Set("source_api_association_config", flattenSourceAPIAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_api_association_config: %s", err)
+	}
+	d.Set("source_api_id", association.SourceApiId)
+
+	return diags
+}
+
+func resourceSourceAPIAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+	input := &appsync.UpdateSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		Description:         aws.String(d.Get("description").(string)),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.UpdateSourceApiAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationMerged(ctx, conn, mergedAPIID, associationID, sourceAPIAssociationMergeType(output.SourceApiAssociation), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+
+	log.Printf("[INFO] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApiWithContext(ctx, &appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationDeleted(ctx, conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSourceAPIAssociationByTwoPartKey(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	input := &appsync.GetSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	output, err := conn.GetSourceApiAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SourceApiAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SourceApiAssociation, nil
+}
+
+func expandSourceAPIAssociationConfig(tfMap map[string]interface{}) *appsync.SourceApiAssociationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appsync.SourceApiAssociationConfig{}
+
+	if v, ok := tfMap["merge_type"].(string); ok && v != "" {
+		apiObject.MergeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceAPIAssociationConfig(apiObject *appsync.SourceApiAssociationConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"merge_type": aws.StringValue(apiObject.MergeType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func sourceAPIAssociationMergeType(apiObject *appsync.SourceApiAssociation) string {
+	if apiObject == nil || apiObject.SourceApiAssociationConfig == nil {
+		return appsync.MergeTypeManualMerge
+	}
+
+	return aws.StringValue(apiObject.SourceApiAssociationConfig.MergeType)
+}
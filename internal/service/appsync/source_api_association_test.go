@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSourceAPIAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+	mergedAPIResourceName := "aws_appsync_graphql_api.merged"
+	sourceAPIResourceName := "aws_appsync_graphql_api.source"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appsync", regexache.MustCompile(`apis/.+/sourceApiAssociations/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_arn", mergedAPIResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_id", mergedAPIResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_arn", sourceAPIResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_id", sourceAPIResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappsync.ResourceSourceAPIAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_description(rName, "description1", "MANUAL_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "MANUAL_MERGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_description(rName, "description2", "AUTO_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceAPIAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appsync_source_api_association" {
+				continue
+			}
+
+			_, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["merged_api_id"], rs.Primary.Attributes["association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppSync Source API Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSourceAPIAssociationExists(ctx context.Context, n string, v *appsync.SourceApiAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
+
+		output, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["merged_api_id"], rs.Primary.Attributes["association_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceAPIAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGraphQLAPIConfig_mergedAPIBase(rName), fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "merged" {
+  api_type                      = "MERGED"
+  authentication_type           = "API_KEY"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+  name                          = "%[1]s-merged"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = "%[1]s-source"
+
+  schema = <<EOF
+type Query {
+  hello: String
+}
+
+schema {
+  query: Query
+}
+EOF
+}
+`, rName))
+}
+
+func testAccSourceAPIAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName), `
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  source_api_association_config {
+    merge_type = "AUTO_MERGE"
+  }
+}
+`)
+}
+
+func testAccSourceAPIAssociationConfig_description(rName, description, mergeType string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_source_api_association" "test" {
+  description   = %[1]q
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  source_api_association_config {
+    merge_type = %[2]q
+  }
+}
+`, description, mergeType))
+}
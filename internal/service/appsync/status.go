@@ -43,3 +43,19 @@ func statusDomainNameAPIAssociation(ctx context.Context, conn *appsync.AppSync,
 		return output, aws.StringValue(output.AssociationStatus), nil
 	}
 }
+
+func statusSourceAPIAssociation(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SourceApiAssociationStatus), nil
+	}
+}
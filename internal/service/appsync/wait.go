@@ -5,10 +5,13 @@ package appsync
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -69,3 +72,50 @@ func waitDomainNameAPIDisassociation(ctx context.Context, conn *appsync.AppSync,
 
 	return err
 }
+
+func waitSourceAPIAssociationMerged(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID, mergeType string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	pending := []string{appsync.SourceApiAssociationStatusMergeScheduled, appsync.SourceApiAssociationStatusMergeInProgress}
+	target := []string{appsync.SourceApiAssociationStatusMergeSuccess}
+
+	// Source API changes are only merged on request when the merge type is MANUAL_MERGE.
+	if mergeType == appsync.MergeTypeManualMerge {
+		pending = []string{appsync.SourceApiAssociationStatusMergeInProgress}
+		target = append(target, appsync.SourceApiAssociationStatusMergeScheduled)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceAPIAssociationDeleted(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusDeletionScheduled, appsync.SourceApiAssociationStatusDeletionInProgress},
+		Target:  []string{},
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
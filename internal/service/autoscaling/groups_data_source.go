@@ -111,7 +111,7 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta inte
 	sort.Strings(arns)
 	sort.Strings(names)
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
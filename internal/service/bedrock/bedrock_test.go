@@ -13,6 +13,12 @@ func TestAccBedrock_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"CustomModel": {
+			"basic":                testAccCustomModel_basic,
+			"kmsKey":               testAccCustomModel_kmsKey,
+			"tags":                 testAccCustomModel_tags,
+			"validationDataConfig": testAccCustomModel_validationDataConfig,
+		},
 		"ModelInvocationLoggingConfiguration": {
 			"basic":      testAccModelInvocationLoggingConfiguration_basic,
 			"disappears": testAccModelInvocationLoggingConfiguration_disappears,
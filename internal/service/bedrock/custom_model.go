@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Model")
+// @Tags(identifierAttribute="job_arn")
+func newResourceCustomModel(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCustomModel{}
+
+	r.SetDefaultDeleteTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCustomModel struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCustomModel) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_custom_model"
+}
+
+func (r *resourceCustomModel) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3URIBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[customModelS3URIModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"s3_uri": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_model_identifier": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_model_kms_key_id": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"hyperparameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ModelCustomizationJobStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"training_metrics": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customModelTrainingMetricsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[customModelTrainingMetricsModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validation_metrics": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customModelValidationMetricsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[customModelValidationMetricsModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_data_config": s3URIBlock(),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+			"training_data_config": s3URIBlock(),
+			"validation_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customModelValidationDataConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"validator": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customModelS3URIModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeBetween(1, 10),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_uri": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"vpc_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customModelVPCConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"subnet_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomModel) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := &bedrock.CreateModelCustomizationJobInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.CustomModelTags = getTagsIn(ctx)
+	input.JobTags = getTagsIn(ctx)
+
+	output, err := conn.CreateModelCustomizationJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Custom Model customization job (%s)", data.JobName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.JobARN = flex.StringToFramework(ctx, output.JobArn)
+	data.setID()
+
+	job, err := findModelCustomizationJobByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CustomModelARN = flex.StringToFramework(ctx, job.OutputModelArn)
+	data.JobStatus = fwtypes.StringEnumValue(job.Status)
+	data.TrainingMetrics = fwtypes.NewListNestedObjectValueOfNull[customModelTrainingMetricsModel](ctx)
+	data.ValidationMetrics = fwtypes.NewListNestedObjectValueOfNull[customModelValidationMetricsModel](ctx)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomModel) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	job, err := findModelCustomizationJobByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Once the job has completed the custom model must exist.
+	if job.Status == awstypes.ModelCustomizationJobStatusCompleted {
+		if _, err := findCustomModelByID(ctx, conn, aws.ToString(job.OutputModelArn)); tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		} else if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", aws.ToString(job.OutputModelArn)), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, job, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.BaseModelIdentifier = flex.StringToFrameworkARN(ctx, job.BaseModelArn)
+	data.CustomModelARN = flex.StringToFramework(ctx, job.OutputModelArn)
+	if job.OutputModelKmsKeyArn != nil {
+		data.CustomModelKmsKeyID = flex.StringToFrameworkARN(ctx, job.OutputModelKmsKeyArn)
+	}
+	data.CustomModelName = flex.StringToFramework(ctx, job.OutputModelName)
+	data.JobStatus = fwtypes.StringEnumValue(job.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomModel) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+}
+
+func (r *resourceCustomModel) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	job, err := findModelCustomizationJobByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if job.Status == awstypes.ModelCustomizationJobStatusInProgress {
+		_, err := conn.StopModelCustomizationJob(ctx, &bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(data.ID.ValueString()),
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Custom Model customization job (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		job, err = waitModelCustomizationJobStopped(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) stop", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Only a completed job produces a custom model.
+	if job.Status != awstypes.ModelCustomizationJobStatusCompleted {
+		return
+	}
+
+	_, err = conn.DeleteCustomModel(ctx, &bedrock.DeleteCustomModelInput{
+		ModelIdentifier: job.OutputModelArn,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Custom Model (%s)", aws.ToString(job.OutputModelArn)), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceCustomModel) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceCustomModel) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type customModelResourceModel struct {
+	BaseModelIdentifier  fwtypes.ARN                                                           `tfsdk:"base_model_identifier"`
+	CustomModelARN       types.String                                                          `tfsdk:"custom_model_arn"`
+	CustomModelKmsKeyID  fwtypes.ARN                                                           `tfsdk:"custom_model_kms_key_id"`
+	CustomModelName      types.String                                                          `tfsdk:"custom_model_name"`
+	HyperParameters      types.Map                                                             `tfsdk:"hyperparameters"`
+	ID                   types.String                                                          `tfsdk:"id"`
+	JobARN               types.String                                                          `tfsdk:"job_arn"`
+	JobName              types.String                                                          `tfsdk:"job_name"`
+	JobStatus            fwtypes.StringEnum[awstypes.ModelCustomizationJobStatus]              `tfsdk:"job_status"`
+	OutputDataConfig     fwtypes.ListNestedObjectValueOf[customModelS3URIModel]                `tfsdk:"output_data_config"`
+	RoleARN              fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	Tags                 types.Map                                                             `tfsdk:"tags"`
+	TagsAll              types.Map                                                             `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                                        `tfsdk:"timeouts"`
+	TrainingDataConfig   fwtypes.ListNestedObjectValueOf[customModelS3URIModel]                `tfsdk:"training_data_config"`
+	TrainingMetrics      fwtypes.ListNestedObjectValueOf[customModelTrainingMetricsModel]      `tfsdk:"training_metrics"`
+	ValidationDataConfig fwtypes.ListNestedObjectValueOf[customModelValidationDataConfigModel] `tfsdk:"validation_data_config"`
+	ValidationMetrics    fwtypes.ListNestedObjectValueOf[customModelValidationMetricsModel]    `tfsdk:"validation_metrics"`
+	VPCConfig            fwtypes.ListNestedObjectValueOf[customModelVPCConfigModel]            `tfsdk:"vpc_config"`
+}
+
+func (data *customModelResourceModel) InitFromID() {
+	data.JobARN = data.ID
+}
+
+func (data *customModelResourceModel) setID() {
+	data.ID = data.JobARN
+}
+
+type customModelS3URIModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type customModelTrainingMetricsModel struct {
+	TrainingLoss types.Float64 `tfsdk:"training_loss"`
+}
+
+type customModelValidationDataConfigModel struct {
+	Validators fwtypes.ListNestedObjectValueOf[customModelS3URIModel] `tfsdk:"validator"`
+}
+
+type customModelValidationMetricsModel struct {
+	ValidationLoss types.Float64 `tfsdk:"validation_loss"`
+}
+
+type customModelVPCConfigModel struct {
+	SecurityGroupIDs types.Set `tfsdk:"security_group_ids"`
+	SubnetIDs        types.Set `tfsdk:"subnet_ids"`
+}
+
+func findCustomModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelCustomizationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findModelCustomizationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusInProgress, awstypes.ModelCustomizationJobStatusStopping),
+		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusStopped),
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
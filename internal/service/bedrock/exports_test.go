@@ -5,5 +5,11 @@ package bedrock
 
 // Exports for use in tests only.
 var (
+	ResourceCustomModel                         = newResourceCustomModel
 	ResourceModelInvocationLoggingConfiguration = newResourceModelInvocationLoggingConfiguration
+	ResourceProvisionedModelThroughput          = newResourceProvisionedModelThroughput
+
+	FindCustomModelByID                = findCustomModelByID
+	FindModelCustomizationJobByID      = findModelCustomizationJobByID
+	FindProvisionedModelThroughputByID = findProvisionedModelThroughputByID
 )
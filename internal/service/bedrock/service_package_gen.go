@@ -29,10 +29,24 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceCustomModel,
+			Name:    "Custom Model",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "job_arn",
+			},
+		},
 		{
 			Factory: newResourceModelInvocationLoggingConfiguration,
 			Name:    "Model Invocation Logging Configuration",
 		},
+		{
+			Factory: newResourceProvisionedModelThroughput,
+			Name:    "Provisioned Model Throughput",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "provisioned_model_arn",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,638 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent")
+// @Tags(identifierAttribute="agent_arn")
+func newResourceAgent(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceAgent{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceAgent struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceAgent) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent"
+}
+
+func (r *resourceAgent) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_arn": framework.ARNAttributeComputedOnly(),
+			"agent_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"agent_resource_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"agent_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"foundation_model": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idle_session_ttl_in_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(60, 3600),
+				},
+			},
+			"instruction": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(40, 4000),
+				},
+			},
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"prompt_override_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[promptOverrideConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"override_lambda": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"prompt_configurations": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[promptConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"base_prompt_template": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100000),
+										},
+									},
+									"parser_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CreationMode](),
+										Required:   true,
+									},
+									"prompt_creation_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CreationMode](),
+										Required:   true,
+									},
+									"prompt_state": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.PromptState](),
+										Required:   true,
+									},
+									"prompt_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.PromptType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"inference_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[inferenceConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"max_length": schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(0, 4096),
+													},
+												},
+												"stop_sequences": schema.ListAttribute{
+													ElementType: types.StringType,
+													Required:    true,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
+												},
+												"temperature": schema.Float64Attribute{
+													Required: true,
+													Validators: []validator.Float64{
+														float64validator.Between(0, 1),
+													},
+												},
+												"top_k": schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(0, 500),
+													},
+												},
+												"top_p": schema.Float64Attribute{
+													Required: true,
+													Validators: []validator.Float64{
+														float64validator.Between(0, 1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceAgent) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateAgentInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAgent(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent (%s)", data.AgentName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AgentID = flex.StringToFramework(ctx, output.Agent.AgentId)
+	data.setID()
+
+	agent, err := waitAgentCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.PrepareAgent.ValueBool() {
+		agent, err = prepareAgent(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	promptOverrideConfiguration := data.PromptOverrideConfiguration
+
+	response.Diagnostics.Append(flex.Flatten(ctx, agent, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if promptOverrideConfiguration.IsNull() {
+		data.PromptOverrideConfiguration = promptOverrideConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAgent) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findAgentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	promptOverrideConfiguration := data.PromptOverrideConfiguration
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API reports the default prompt templates when no override is configured.
+	if promptOverrideConfiguration.IsNull() {
+		data.PromptOverrideConfiguration = promptOverrideConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAgent) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.AgentName.Equal(old.AgentName) ||
+		!new.AgentResourceRoleARN.Equal(old.AgentResourceRoleARN) ||
+		!new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FoundationModel.Equal(old.FoundationModel) ||
+		!new.IdleSessionTTLInSeconds.Equal(old.IdleSessionTTLInSeconds) ||
+		!new.Instruction.Equal(old.Instruction) ||
+		!new.PromptOverrideConfiguration.Equal(old.PromptOverrideConfiguration) {
+		// UpdateAgent replaces the whole agent definition.
+		input := &bedrockagent.UpdateAgentInput{}
+		response.Diagnostics.Append(flex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateAgent(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		agent, err := waitAgentUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if new.PrepareAgent.ValueBool() {
+			agent, err = prepareAgent(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		promptOverrideConfiguration := new.PromptOverrideConfiguration
+
+		response.Diagnostics.Append(flex.Flatten(ctx, agent, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if promptOverrideConfiguration.IsNull() {
+			new.PromptOverrideConfiguration = promptOverrideConfiguration
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceAgent) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteAgent(ctx, &bedrockagent.DeleteAgentInput{
+		AgentId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAgentDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceAgent) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceAgent) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareAgent(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	_, err := conn.PrepareAgent(ctx, &bedrockagent.PrepareAgentInput{
+		AgentId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitAgentPrepared(ctx, conn, id, timeout)
+}
+
+func findAgentByID(ctx context.Context, conn *bedrockagent.Client, id string) (*awstypes.Agent, error) {
+	input := &bedrockagent.GetAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	output, err := conn.GetAgent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Agent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Agent, nil
+}
+
+func statusAgent(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAgentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AgentStatus), nil
+	}
+}
+
+func waitAgentCreated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentUpdated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusUpdating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPreparing),
+		Target:  enum.Slice(awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type agentResourceModel struct {
+	AgentARN                    types.String                                                      `tfsdk:"agent_arn"`
+	AgentID                     types.String                                                      `tfsdk:"agent_id"`
+	AgentName                   types.String                                                      `tfsdk:"agent_name"`
+	AgentResourceRoleARN        fwtypes.ARN                                                       `tfsdk:"agent_resource_role_arn"`
+	AgentVersion                types.String                                                      `tfsdk:"agent_version"`
+	CustomerEncryptionKeyARN    fwtypes.ARN                                                       `tfsdk:"customer_encryption_key_arn"`
+	Description                 types.String                                                      `tfsdk:"description"`
+	FoundationModel             types.String                                                      `tfsdk:"foundation_model"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	IdleSessionTTLInSeconds     types.Int64                                                       `tfsdk:"idle_session_ttl_in_seconds"`
+	Instruction                 types.String                                                      `tfsdk:"instruction"`
+	PrepareAgent                types.Bool                                                        `tfsdk:"prepare_agent"`
+	PromptOverrideConfiguration fwtypes.ListNestedObjectValueOf[promptOverrideConfigurationModel] `tfsdk:"prompt_override_configuration"`
+	Tags                        types.Map                                                         `tfsdk:"tags"`
+	TagsAll                     types.Map                                                         `tfsdk:"tags_all"`
+	Timeouts                    timeouts.Value                                                    `tfsdk:"timeouts"`
+}
+
+func (data *agentResourceModel) InitFromID() {
+	data.AgentID = data.ID
+}
+
+func (data *agentResourceModel) setID() {
+	data.ID = data.AgentID
+}
+
+type promptOverrideConfigurationModel struct {
+	OverrideLambda       fwtypes.ARN                                               `tfsdk:"override_lambda"`
+	PromptConfigurations fwtypes.ListNestedObjectValueOf[promptConfigurationModel] `tfsdk:"prompt_configurations"`
+}
+
+type promptConfigurationModel struct {
+	BasePromptTemplate     types.String                                                 `tfsdk:"base_prompt_template"`
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[inferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ParserMode             fwtypes.StringEnum[awstypes.CreationMode]                    `tfsdk:"parser_mode"`
+	PromptCreationMode     fwtypes.StringEnum[awstypes.CreationMode]                    `tfsdk:"prompt_creation_mode"`
+	PromptState            fwtypes.StringEnum[awstypes.PromptState]                     `tfsdk:"prompt_state"`
+	PromptType             fwtypes.StringEnum[awstypes.PromptType]                      `tfsdk:"prompt_type"`
+}
+
+type inferenceConfigurationModel struct {
+	MaximumLength types.Int64   `tfsdk:"max_length"`
+	StopSequences types.List    `tfsdk:"stop_sequences"`
+	Temperature   types.Float64 `tfsdk:"temperature"`
+	TopK          types.Int64   `tfsdk:"top_k"`
+	TopP          types.Float64 `tfsdk:"top_p"`
+}
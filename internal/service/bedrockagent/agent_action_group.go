@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent Action Group")
+func newResourceAgentActionGroup(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAgentActionGroup{}, nil
+}
+
+const (
+	agentActionGroupResourceIDPartCount = 3
+)
+
+type resourceAgentActionGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAgentActionGroup) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent_action_group"
+}
+
+func (r *resourceAgentActionGroup) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"action_group_name": schema.StringAttribute{
+				Required: true,
+			},
+			"action_group_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionGroupState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("DRAFT"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"parent_action_group_signature": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionGroupSignature](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"action_group_executor": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[actionGroupExecutorModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"lambda": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"api_schema": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"payload": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("payload"),
+									path.MatchRelative().AtParent().AtName("s3"),
+								),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3IdentifierModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_bucket_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"s3_object_key": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAgentActionGroup) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateAgentActionGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateAgentActionGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Action Group (%s)", data.ActionGroupName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ActionGroupID = fwflex.StringToFramework(ctx, output.AgentActionGroup.ActionGroupId)
+	data.ActionGroupState = fwtypes.StringEnumValue(output.AgentActionGroup.ActionGroupState)
+	if err := data.setID(); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Action Group (%s)", data.ActionGroupName.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAgentActionGroup) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findAgentActionGroupByThreePartKey(ctx, conn, data.ActionGroupID.ValueString(), data.AgentID.ValueString(), data.AgentVersion.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API reports the parent action group signature under a different name.
+	if output.ParentActionSignature != "" {
+		data.ParentActionGroupSignature = fwtypes.StringEnumValue(output.ParentActionSignature)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAgentActionGroup) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	// UpdateAgentActionGroup replaces the whole action group definition.
+	input := &bedrockagent.UpdateAgentActionGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateAgentActionGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Action Group (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.ActionGroupState = fwtypes.StringEnumValue(output.AgentActionGroup.ActionGroupState)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceAgentActionGroup) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteAgentActionGroup(ctx, &bedrockagent.DeleteAgentActionGroupInput{
+		ActionGroupId: fwflex.StringFromFramework(ctx, data.ActionGroupID),
+		AgentId:       fwflex.StringFromFramework(ctx, data.AgentID),
+		AgentVersion:  fwflex.StringFromFramework(ctx, data.AgentVersion),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findAgentActionGroupByThreePartKey(ctx context.Context, conn *bedrockagent.Client, actionGroupID, agentID, agentVersion string) (*awstypes.AgentActionGroup, error) {
+	input := &bedrockagent.GetAgentActionGroupInput{
+		ActionGroupId: aws.String(actionGroupID),
+		AgentId:       aws.String(agentID),
+		AgentVersion:  aws.String(agentVersion),
+	}
+
+	output, err := conn.GetAgentActionGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentActionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentActionGroup, nil
+}
+
+type agentActionGroupResourceModel struct {
+	ActionGroupExecutor        fwtypes.ListNestedObjectValueOf[actionGroupExecutorModel] `tfsdk:"action_group_executor"`
+	ActionGroupID              types.String                                              `tfsdk:"action_group_id"`
+	ActionGroupName            types.String                                              `tfsdk:"action_group_name"`
+	ActionGroupState           fwtypes.StringEnum[awstypes.ActionGroupState]             `tfsdk:"action_group_state"`
+	AgentID                    types.String                                              `tfsdk:"agent_id"`
+	AgentVersion               types.String                                              `tfsdk:"agent_version"`
+	APISchema                  fwtypes.ListNestedObjectValueOf[apiSchemaModel]           `tfsdk:"api_schema"`
+	Description                types.String                                              `tfsdk:"description"`
+	ID                         types.String                                              `tfsdk:"id"`
+	ParentActionGroupSignature fwtypes.StringEnum[awstypes.ActionGroupSignature]         `tfsdk:"parent_action_group_signature"`
+}
+
+func (data *agentActionGroupResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), agentActionGroupResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.ActionGroupID = types.StringValue(parts[0])
+	data.AgentID = types.StringValue(parts[1])
+	data.AgentVersion = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *agentActionGroupResourceModel) setID() error {
+	id, err := flex.FlattenResourceId([]string{data.ActionGroupID.ValueString(), data.AgentID.ValueString(), data.AgentVersion.ValueString()}, agentActionGroupResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(id)
+
+	return nil
+}
+
+// actionGroupExecutorModel models the awstypes.ActionGroupExecutor union.
+type actionGroupExecutorModel struct {
+	Lambda fwtypes.ARN `tfsdk:"lambda"`
+}
+
+var (
+	_ fwflex.Expander  = actionGroupExecutorModel{}
+	_ fwflex.Flattener = &actionGroupExecutorModel{}
+)
+
+func (m actionGroupExecutorModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	if !m.Lambda.IsNull() {
+		return &awstypes.ActionGroupExecutorMemberLambda{
+			Value: m.Lambda.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *actionGroupExecutorModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	if t, ok := v.(*awstypes.ActionGroupExecutorMemberLambda); ok {
+		m.Lambda = fwtypes.ARNValue(t.Value)
+	}
+
+	return diags
+}
+
+// apiSchemaModel models the awstypes.APISchema union.
+type apiSchemaModel struct {
+	Payload types.String                                       `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3IdentifierModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = apiSchemaModel{}
+	_ fwflex.Flattener = &apiSchemaModel{}
+)
+
+func (m apiSchemaModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Payload.IsNull():
+		return &awstypes.APISchemaMemberPayload{
+			Value: m.Payload.ValueString(),
+		}, diags
+
+	case !m.S3.IsNull():
+		s3IdentifierData, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.APISchemaMemberS3
+		diags.Append(fwflex.Expand(ctx, s3IdentifierData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *apiSchemaModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.APISchemaMemberPayload:
+		m.Payload = types.StringValue(t.Value)
+		m.S3 = fwtypes.NewListNestedObjectValueOfNull[s3IdentifierModel](ctx)
+
+	case *awstypes.APISchemaMemberS3:
+		var s3IdentifierData s3IdentifierModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &s3IdentifierData)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtr(ctx, &s3IdentifierData)
+	}
+
+	return diags
+}
+
+type s3IdentifierModel struct {
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3ObjectKey  types.String `tfsdk:"s3_object_key"`
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+// Exports for use in tests only.
+var (
+	ResourceAgent            = newResourceAgent
+	ResourceAgentActionGroup = newResourceAgentActionGroup
+	ResourceAgentAlias       = newResourceAgentAlias
+	ResourceDataSource       = newResourceDataSource
+	ResourceKnowledgeBase    = newResourceKnowledgeBase
+
+	FindAgentActionGroupByThreePartKey = findAgentActionGroupByThreePartKey
+	FindAgentAliasByTwoPartKey         = findAgentAliasByTwoPartKey
+	FindAgentByID                      = findAgentByID
+	FindDataSourceByTwoPartKey         = findDataSourceByTwoPartKey
+	FindKnowledgeBaseByID              = findKnowledgeBaseByID
+)
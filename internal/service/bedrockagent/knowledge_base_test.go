@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Creating the vector index inside an OpenSearch Serverless collection is not
+// possible with this provider, so the acceptance tests require an existing
+// collection containing an index named "bedrock-knowledge-base-default-index".
+const envVarKnowledgeBaseCollectionName = "TF_AWS_BEDROCK_OSS_COLLECTION_NAME"
+
+func TestAccBedrockAgentKnowledgeBase_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	var v awstypes.KnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "initial"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.type", "VECTOR"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.vector_knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.type", "OPENSEARCH_SERVERLESS"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.opensearch_serverless_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_configuration.0.opensearch_serverless_configuration.0.collection_arn", "data.aws_opensearchserverless_collection.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.opensearch_serverless_configuration.0.vector_index_name", "bedrock-knowledge-base-default-index"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentKnowledgeBase_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	var v awstypes.KnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceKnowledgeBase, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentKnowledgeBase_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	var v awstypes.KnowledgeBase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_tags1(rName, collectionName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_tags2(rName, collectionName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_tags1(rName, collectionName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKnowledgeBaseDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_knowledge_base" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Knowledge Base %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKnowledgeBaseExists(ctx context.Context, n string, v *awstypes.KnowledgeBase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccKnowledgeBaseConfig_base(rName, collectionName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+data "aws_opensearchserverless_collection" "test" {
+  name = %[2]q
+}
+
+data "aws_iam_policy_document" "test_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      identifiers = ["bedrock.amazonaws.com"]
+      type        = "Service"
+    }
+
+    condition {
+      test     = "StringEquals"
+      values   = [data.aws_caller_identity.current.account_id]
+      variable = "aws:SourceAccount"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test_trust.json
+}
+
+data "aws_iam_policy_document" "test_permissions" {
+  statement {
+    actions   = ["bedrock:InvokeModel"]
+    resources = ["arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"]
+  }
+
+  statement {
+    actions   = ["aoss:APIAccessAll"]
+    resources = [data.aws_opensearchserverless_collection.test.arn]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  role   = aws_iam_role.test.id
+  policy = data.aws_iam_policy_document.test_permissions.json
+}
+
+resource "aws_opensearchserverless_access_policy" "test" {
+  name = %[1]q
+  type = "data"
+  policy = jsonencode([
+    {
+      Rules = [
+        {
+          ResourceType = "index"
+          Resource     = ["index/${data.aws_opensearchserverless_collection.test.name}/*"]
+          Permission   = ["aoss:*"]
+        },
+        {
+          ResourceType = "collection"
+          Resource     = ["collection/${data.aws_opensearchserverless_collection.test.name}"]
+          Permission   = ["aoss:*"]
+        }
+      ]
+      Principal = [aws_iam_role.test.arn]
+    }
+  ])
+}
+`, rName, collectionName)
+}
+
+func testAccKnowledgeBaseConfig_resource(rName, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+  knowledge_base_configuration {
+    type = "VECTOR"
+
+    vector_knowledge_base_configuration {
+      embedding_model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+    }
+  }
+
+  storage_configuration {
+    type = "OPENSEARCH_SERVERLESS"
+
+    opensearch_serverless_configuration {
+      collection_arn    = data.aws_opensearchserverless_collection.test.arn
+      vector_index_name = "bedrock-knowledge-base-default-index"
+
+      field_mapping {
+        metadata_field = "AMAZON_BEDROCK_METADATA"
+        text_field     = "AMAZON_BEDROCK_TEXT_CHUNK"
+        vector_field   = "bedrock-knowledge-base-default-vector"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_opensearchserverless_access_policy.test]
+}
+`, rName, extra)
+}
+
+func testAccKnowledgeBaseConfig_basic(rName, collectionName, description string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName, collectionName), testAccKnowledgeBaseConfig_resource(rName, fmt.Sprintf(`
+  description = %[1]q
+`, description)))
+}
+
+func testAccKnowledgeBaseConfig_tags1(rName, collectionName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName, collectionName), testAccKnowledgeBaseConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1)))
+}
+
+func testAccKnowledgeBaseConfig_tags2(rName, collectionName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName, collectionName), testAccKnowledgeBaseConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2)))
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+// FindLatestWriteEventByResourceNames returns the most recent CloudTrail management event that modified any of the specified resources.
+// Only the first page of event history is examined for each resource name as LookupEvents is heavily throttled.
+func FindLatestWriteEventByResourceNames(ctx context.Context, conn *cloudtrail.CloudTrail, resourceNames []string) (*cloudtrail.Event, error) {
+	for _, resourceName := range resourceNames {
+		input := &cloudtrail.LookupEventsInput{
+			LookupAttributes: []*cloudtrail.LookupAttribute{{
+				AttributeKey:   aws.String(cloudtrail.LookupAttributeKeyResourceName),
+				AttributeValue: aws.String(resourceName),
+			}},
+			MaxResults: aws.Int64(50),
+		}
+
+		output, err := conn.LookupEventsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Events are returned in reverse chronological order.
+		for _, event := range output.Events {
+			if event == nil || aws.StringValue(event.ReadOnly) == "true" {
+				continue
+			}
+
+			return event, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// CloudWatch retains metric data for 15 months.
+	metricDataMaxTimeRange = 455 * 24 * time.Hour
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_data")
+func dataSourceMetricData() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricDataRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_datapoints": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"metric_data_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"messages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+			"metric_query": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`), "must start with a lowercase letter and contain only letters, numbers and underscores"),
+							),
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexache.MustCompile(`[^:].*`), "must not contain colon characters"),
+										),
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(cloudwatch.StandardUnit_Values(), false),
+									},
+								},
+							},
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{1, 5, 10, 30}),
+								validation.IntDivisibleBy(60),
+							),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"scan_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatch.ScanBy_Values(), false),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func dataSourceMetricDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn(ctx)
+
+	startTime, endTime, err := expandMetricDataTimeRange(d.Get("start_time").(string), d.Get("end_time").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: expandMetricAlarmMetrics(d.Get("metric_query").(*schema.Set)),
+		StartTime:         aws.Time(startTime),
+	}
+
+	if v, ok := d.GetOk("max_datapoints"); ok {
+		input.MaxDatapoints = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("scan_by"); ok {
+		input.ScanBy = aws.String(v.(string))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	results, err := findMetricDataResults(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Metric Data: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
+	if err := d.Set("metric_data_results", flattenMetricDataResults(results)); err != nil {
+		return diag.Errorf("setting metric_data_results: %s", err)
+	}
+
+	return nil
+}
+
+func expandMetricDataTimeRange(start, end string) (time.Time, time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, start)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("parsing start_time (%s): %w", start, err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, end)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("parsing end_time (%s): %w", end, err)
+	}
+
+	if !endTime.After(startTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end_time (%s) must be after start_time (%s)", end, start)
+	}
+
+	if endTime.Sub(startTime) > metricDataMaxTimeRange {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range between start_time (%s) and end_time (%s) must not exceed %s", start, end, metricDataMaxTimeRange)
+	}
+
+	return startTime, endTime, nil
+}
+
+// findMetricDataResults returns one result per query ID, merging datapoints that are split across pages.
+func findMetricDataResults(ctx context.Context, conn *cloudwatch.CloudWatch, input *cloudwatch.GetMetricDataInput) ([]*cloudwatch.MetricDataResult, error) {
+	var output []*cloudwatch.MetricDataResult
+	byID := make(map[string]*cloudwatch.MetricDataResult)
+
+	err := conn.GetMetricDataPagesWithContext(ctx, input, func(page *cloudwatch.GetMetricDataOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricDataResults {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.Id)
+			if result, ok := byID[id]; ok {
+				result.Messages = append(result.Messages, v.Messages...)
+				result.StatusCode = v.StatusCode
+				result.Timestamps = append(result.Timestamps, v.Timestamps...)
+				result.Values = append(result.Values, v.Values...)
+
+				continue
+			}
+
+			byID[id] = v
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenMetricDataResults(apiObjects []*cloudwatch.MetricDataResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var messages []interface{}
+		for _, v := range apiObject.Messages {
+			messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Value)))
+		}
+
+		var timestamps []interface{}
+		for _, v := range apiObject.Timestamps {
+			timestamps = append(timestamps, aws.TimeValue(v).Format(time.RFC3339))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":          aws.StringValue(apiObject.Id),
+			"label":       aws.StringValue(apiObject.Label),
+			"messages":    messages,
+			"status_code": aws.StringValue(apiObject.StatusCode),
+			"timestamps":  timestamps,
+			"values":      aws.Float64ValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
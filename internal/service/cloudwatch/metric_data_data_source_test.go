@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchMetricDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "metric_data_results.*", map[string]string{
+						"id":          "m1",
+						"status_code": "Complete",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "metric_data_results.*", map[string]string{
+						"id":          "e1",
+						"label":       "Doubled",
+						"status_code": "Complete",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricDataDataSource_invalidTimeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricDataDataSourceConfig_timeRange("2023-11-02T00:00:00Z", "2023-11-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`end_time .* must be after start_time`),
+			},
+			{
+				Config:      testAccMetricDataDataSourceConfig_timeRange("2020-01-01T00:00:00Z", "2023-11-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`must not exceed`),
+			},
+		},
+	})
+}
+
+func testAccMetricDataDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = timeadd(timestamp(), "-3h")
+  end_time   = timestamp()
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      namespace   = "AWS/Logs"
+      metric_name = "IncomingBytes"
+      period      = 300
+      stat        = "Sum"
+
+      dimensions = {
+        LogGroupName = aws_cloudwatch_log_group.test.name
+      }
+    }
+  }
+
+  metric_query {
+    id          = "e1"
+    expression  = "m1 * 2"
+    label       = "Doubled"
+    return_data = true
+  }
+}
+`, rName)
+}
+
+func testAccMetricDataDataSourceConfig_timeRange(startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = %[1]q
+  end_time   = %[2]q
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      namespace   = "AWS/Logs"
+      metric_name = "IncomingBytes"
+      period      = 300
+      stat        = "Sum"
+    }
+  }
+}
+`, startTime, endTime)
+}
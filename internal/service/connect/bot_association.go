@@ -89,7 +89,7 @@ func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("lex_bot"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		lexBot := expandLexBot(v.([]interface{}))
 		if lexBot.LexRegion == nil {
-			lexBot.LexRegion = aws.String(meta.(*conns.AWSClient).Region)
+			lexBot.LexRegion = aws.String(meta.(*conns.AWSClient).ResourceRegion(d))
 		}
 		input.LexBot = lexBot
 	}
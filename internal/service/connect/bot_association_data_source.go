@@ -66,7 +66,7 @@ func dataSourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("finding Connect Bot Association (%s,%s) : not found", instanceID, name)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 
 	d.Set("instance_id", instanceID)
 	if err := d.Set("lex_bot", flattenLexBot(lexBot)); err != nil {
@@ -44,7 +44,7 @@ func dataSourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("finding Connect Lambda Function Association by ARN (%s): not found", functionArn)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("function_arn", functionArn)
 	d.Set("instance_id", instanceID)
 
@@ -57,6 +57,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "dynamodb",
+				Resource: "table/{name}",
+			},
 		},
 		{
 			Factory:  ResourceTableItem,
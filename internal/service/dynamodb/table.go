@@ -40,6 +40,7 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @ImportByARN(service="dynamodb", resource="table/{name}")
 func ResourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -815,7 +816,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("stream_label", table.LatestStreamLabel)
 
 	sse := flattenTableServerSideEncryption(table.SSEDescription)
-	sse = clearSSEDefaultKey(ctx, sse, meta)
+	sse = clearSSEDefaultKey(ctx, meta.(*conns.AWSClient).ResourceRegion(d), sse, meta)
 
 	if err := d.Set("server_side_encryption", sse); err != nil {
 		return create.DiagSettingError(names.DynamoDB, ResNameTable, d.Id(), "server_side_encryption", err)
@@ -1073,7 +1074,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 			var input = &dynamodb.UpdateReplicationGroupMemberAction{
 				KMSMasterKeyId: expandEncryptAtRestOptions(d.Get("server_side_encryption").([]interface{})).KMSMasterKeyId,
-				RegionName:     aws.String(meta.(*conns.AWSClient).Region),
+				RegionName:     aws.String(meta.(*conns.AWSClient).ResourceRegion(d)),
 			}
 			var update = &dynamodb.ReplicationGroupUpdate{Update: input}
 			replicaInputs = append(replicaInputs, update)
@@ -1880,14 +1881,14 @@ func addReplicaTagPropagates(configReplicas *schema.Set, replicas []interface{})
 
 // clearSSEDefaultKey sets the kms_key_arn to "" if it is the default key alias/aws/dynamodb.
 // Not clearing the key causes diff problems and sends the key to AWS when it should not be.
-func clearSSEDefaultKey(ctx context.Context, sseList []interface{}, meta interface{}) []interface{} {
+func clearSSEDefaultKey(ctx context.Context, region string, sseList []interface{}, meta interface{}) []interface{} {
 	if len(sseList) == 0 {
 		return sseList
 	}
 
 	sse := sseList[0].(map[string]interface{})
 
-	dk, err := kms.FindDefaultKey(ctx, "dynamodb", meta.(*conns.AWSClient).Region, meta)
+	dk, err := kms.FindDefaultKey(ctx, "dynamodb", region, meta)
 	if err != nil {
 		return sseList
 	}
@@ -445,7 +445,7 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 	// ARN
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		Service:   ec2.ServiceName,
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("instance/%s", d.Id()),
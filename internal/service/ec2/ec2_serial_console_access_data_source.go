@@ -39,7 +39,7 @@ func dataSourceSerialConsoleAccessRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("reading EC2 Serial Console Access: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("enabled", output.SerialConsoleAccessEnabled)
 
 	return nil
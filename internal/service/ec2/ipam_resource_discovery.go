@@ -86,7 +86,7 @@ func ResourceIPAMResourceDiscovery() *schema.Resource {
 			// user must define authn region within `operating_regions {}`
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				if diff.Id() == "" { // Create.
-					currentRegion := meta.(*conns.AWSClient).Region
+					currentRegion := meta.(*conns.AWSClient).ResourceRegion(diff)
 
 					for _, v := range diff.Get("operating_regions").(*schema.Set).List() {
 						if v.(map[string]interface{})["region_name"].(string) == currentRegion {
@@ -67,7 +67,7 @@ func dataSourceLocalGatewayRouteTablesRead(ctx context.Context, d *schema.Resour
 		routeTableIDs = append(routeTableIDs, aws.StringValue(v.LocalGatewayRouteTableId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", routeTableIDs)
 
 	return diags
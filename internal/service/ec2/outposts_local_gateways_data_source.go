@@ -67,7 +67,7 @@ func dataSourceLocalGatewaysRead(ctx context.Context, d *schema.ResourceData, me
 		gatewayIDs = append(gatewayIDs, aws.StringValue(v.LocalGatewayId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", gatewayIDs)
 
 	return diags
@@ -43,6 +43,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ec2",
+				Resource: "security-group-rule/{id}",
+			},
 		},
 		{
 			Factory: newResourceSecurityGroupIngressRule,
@@ -50,6 +54,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ec2",
+				Resource: "security-group-rule/{id}",
+			},
 		},
 	}
 }
@@ -911,6 +919,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ec2",
+				Resource: "security-group/{id}",
+			},
 		},
 		{
 			Factory:  ResourceSecurityGroupRule,
@@ -947,6 +959,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ec2",
+				Resource: "subnet/{id}",
+			},
 		},
 		{
 			Factory:  ResourceVerifiedAccessEndpoint,
@@ -1001,6 +1017,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ec2",
+				Resource: "vpc/{id}",
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
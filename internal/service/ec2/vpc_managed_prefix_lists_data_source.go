@@ -61,7 +61,7 @@ func dataSourceManagedPrefixListsRead(ctx context.Context, d *schema.ResourceDat
 		prefixListIDs = append(prefixListIDs, aws.StringValue(v.PrefixListId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", prefixListIDs)
 
 	return nil
@@ -79,7 +79,7 @@ func dataSourceNATGatewaysRead(ctx context.Context, d *schema.ResourceData, meta
 		natGatewayIDs = append(natGatewayIDs, aws.StringValue(v.NatGatewayId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", natGatewayIDs)
 
 	return nil
@@ -67,7 +67,7 @@ func dataSourceNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData
 		networkInterfaceIDs = append(networkInterfaceIDs, aws.StringValue(v.NetworkInterfaceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", networkInterfaceIDs)
 
 	return diags
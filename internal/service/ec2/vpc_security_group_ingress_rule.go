@@ -32,6 +32,7 @@ import (
 
 // @FrameworkResource(name="Security Group Ingress Rule")
 // @Tags(identifierAttribute="id")
+// @ImportByARN(service="ec2", resource="security-group-rule/{id}")
 func newResourceSecurityGroupIngressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSecurityGroupIngressRule{}
 	r.create = r.createSecurityGroupRule
@@ -77,7 +77,7 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   ec2.ServiceName,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 			AccountID: aws.StringValue(v.OwnerId),
 			Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(v.GroupId)),
 		}.String()
@@ -86,7 +86,7 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		vpcIDs = append(vpcIDs, aws.StringValue(v.VpcId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", arns)
 	d.Set("ids", securityGroupIDs)
 	d.Set("vpc_ids", vpcIDs)
@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_subnet", name="Subnet")
 // @Tags(identifierAttribute="id")
+// @ImportByARN(service="ec2", resource="subnet/{id}")
 func ResourceSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
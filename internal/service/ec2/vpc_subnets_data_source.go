@@ -70,7 +70,7 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta int
 		subnetIDs = append(subnetIDs, aws.StringValue(v.SubnetId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("ids", subnetIDs)
 
 	return diags
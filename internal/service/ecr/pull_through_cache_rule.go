@@ -12,10 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_ecr_pull_through_cache_rule")
@@ -23,6 +25,7 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePullThroughCacheRuleCreate,
 		ReadWithoutTimeout:   resourcePullThroughCacheRuleRead,
+		UpdateWithoutTimeout: resourcePullThroughCacheRuleUpdate,
 		DeleteWithoutTimeout: resourcePullThroughCacheRuleDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -30,6 +33,11 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"credential_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,12 +53,21 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"upstream_registry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"upstream_registry_url": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
 		},
+
+		// Credentials can be changed but not removed.
+		CustomizeDiff: customdiff.ForceNewIfChange("credential_arn", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(string) != "" && new.(string) == ""
+		}),
 	}
 }
 
@@ -63,6 +80,10 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		UpstreamRegistryUrl: aws.String(d.Get("upstream_registry_url").(string)),
 	}
 
+	if v, ok := d.GetOk("credential_arn"); ok {
+		input.CredentialArn = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Creating ECR Pull Through Cache Rule: %s", input)
 	_, err := conn.CreatePullThroughCacheRuleWithContext(ctx, input)
 
@@ -90,13 +111,33 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("reading ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
 	}
 
+	d.Set("credential_arn", rule.CredentialArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
+	d.Set("upstream_registry", rule.UpstreamRegistry)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
 
 	return nil
 }
 
+func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	input := &ecr.UpdatePullThroughCacheRuleInput{
+		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
+		EcrRepositoryPrefix: aws.String(d.Id()),
+		RegistryId:          aws.String(d.Get("registry_id").(string)),
+	}
+
+	_, err := conn.UpdatePullThroughCacheRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourcePullThroughCacheRuleRead(ctx, d, meta)
+}
+
 func resourcePullThroughCacheRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECRConn(ctx)
 
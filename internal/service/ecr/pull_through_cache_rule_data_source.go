@@ -19,6 +19,10 @@ func DataSourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePullThroughCacheRuleRead,
 		Schema: map[string]*schema.Schema{
+			"credential_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,6 +38,10 @@ func DataSourcePullThroughCacheRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"upstream_registry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"upstream_registry_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -54,8 +62,10 @@ func dataSourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	d.SetId(aws.StringValue(rule.EcrRepositoryPrefix))
+	d.Set("credential_arn", rule.CredentialArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
+	d.Set("upstream_registry", rule.UpstreamRegistry)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
 
 	return nil
@@ -23,6 +23,8 @@ func TestAccECRPullThroughCacheRuleDataSource_basic(t *testing.T) {
 			{
 				Config: testAccPullThroughCacheRuleDataSourceConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource, "credential_arn", ""),
+					resource.TestCheckResourceAttr(dataSource, "upstream_registry", "ecr-public"),
 					resource.TestCheckResourceAttr(dataSource, "upstream_registry_url", "public.ecr.aws"),
 					acctest.CheckResourceAttrAccountID(dataSource, "registry_id"),
 				),
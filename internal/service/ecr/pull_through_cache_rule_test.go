@@ -36,6 +36,8 @@ func TestAccECRPullThroughCacheRule_basic(t *testing.T) {
 					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
 					testAccCheckPullThroughCacheRuleRegistryID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "credential_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry", "ecr-public"),
 					resource.TestCheckResourceAttr(resourceName, "upstream_registry_url", "public.ecr.aws"),
 				),
 			},
@@ -48,6 +50,43 @@ func TestAccECRPullThroughCacheRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry", "docker-hub"),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry_url", "registry-1.docker.io"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
@@ -158,6 +197,42 @@ resource "aws_ecr_pull_through_cache_rule" "test" {
 `, repositoryPrefix)
 }
 
+func testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, secretResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test1" {
+  name                    = "ecr-pullthroughcache/%[2]s-1"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id = aws_secretsmanager_secret.test1.id
+  secret_string = jsonencode({
+    username    = "tfacctest"
+    accessToken = "tfacctest1"
+  })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "ecr-pullthroughcache/%[2]s-2"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id = aws_secretsmanager_secret.test2.id
+  secret_string = jsonencode({
+    username    = "tfacctest"
+    accessToken = "tfacctest2"
+  })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "registry-1.docker.io"
+  credential_arn        = aws_secretsmanager_secret_version.%[3]s.arn
+}
+`, repositoryPrefix, rName, secretResourceName)
+}
+
 func testAccPullThroughCacheRuleConfig_failWhenAlreadyExist(repositoryPrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_ecr_pull_through_cache_rule" "test" {
@@ -5,6 +5,8 @@ package ecr
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ecr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecr"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	ecr_sdkv1 "github.com/aws/aws-sdk-go/service/ecr"
@@ -73,6 +75,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "ecr",
+				Resource: "repository/{name}",
+			},
+		},
+		{
+			Factory:  ResourceRepositoryCreationTemplate,
+			TypeName: "aws_ecr_repository_creation_template",
 		},
 		{
 			Factory:  ResourceRepositoryPolicy,
@@ -92,6 +102,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*e
 	return ecr_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*ecr_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return ecr_sdkv2.NewFromConfig(cfg, func(o *ecr_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
@@ -200,7 +200,7 @@ func resourceAccessPointRead(ctx context.Context, d *schema.ResourceData, meta i
 	fsARN := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		Resource:  fmt.Sprintf("file-system/%s", aws.StringValue(ap.FileSystemId)),
 		Service:   "elasticfilesystem",
 	}.String()
@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_efs_file_system", name="File System")
 // @Tags(identifierAttribute="id")
+// @ImportByARN(service="elasticfilesystem", resource="file-system/{id}")
 func ResourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
@@ -165,7 +165,7 @@ func resourceMountTargetRead(ctx context.Context, d *schema.ResourceData, meta i
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		Resource:  fmt.Sprintf("file-system/%s", aws.StringValue(mt.FileSystemId)),
 		Service:   "elasticfilesystem",
 	}.String()
@@ -65,6 +65,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "elasticfilesystem",
+				Resource: "file-system/{id}",
+			},
 		},
 		{
 			Factory:  ResourceFileSystemPolicy,
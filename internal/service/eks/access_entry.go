@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	accessEntryTypeEC2Linux     = "EC2_LINUX"
+	accessEntryTypeEC2Windows   = "EC2_WINDOWS"
+	accessEntryTypeFargateLinux = "FARGATE_LINUX"
+	accessEntryTypeStandard     = "STANDARD"
+)
+
+func accessEntryType_Values() []string {
+	return []string{
+		accessEntryTypeEC2Linux,
+		accessEntryTypeEC2Windows,
+		accessEntryTypeFargateLinux,
+		accessEntryTypeStandard,
+	}
+}
+
+// @SDKResource("aws_eks_access_entry", name="Access Entry")
+// @Tags(identifierAttribute="access_entry_arn")
+func resourceAccessEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessEntryCreate,
+		ReadWithoutTimeout:   resourceAccessEntryRead,
+		UpdateWithoutTimeout: resourceAccessEntryUpdate,
+		DeleteWithoutTimeout: resourceAccessEntryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_entry_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubernetes_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      accessEntryTypeStandard,
+				ValidateFunc: validation.StringInSlice(accessEntryType_Values(), false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	principalARN := d.Get("principal_arn").(string)
+	id := AccessEntryCreateResourceID(clusterName, principalARN)
+	input := &eks.CreateAccessEntryInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		PrincipalArn:       aws.String(principalARN),
+		Tags:               getTagsIn(ctx),
+		Type:               aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("kubernetes_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.KubernetesGroups = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("user_name"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAccessEntry(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Access Entry (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceAccessEntryRead(ctx, d, meta)...)
+}
+
+func resourceAccessEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	accessEntry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_entry_arn", accessEntry.AccessEntryArn)
+	d.Set("cluster_name", accessEntry.ClusterName)
+	d.Set("created_at", aws.ToTime(accessEntry.CreatedAt).Format(time.RFC3339))
+	d.Set("kubernetes_groups", accessEntry.KubernetesGroups)
+	d.Set("modified_at", aws.ToTime(accessEntry.ModifiedAt).Format(time.RFC3339))
+	d.Set("principal_arn", accessEntry.PrincipalArn)
+	d.Set("type", accessEntry.Type)
+	d.Set("user_name", accessEntry.Username)
+
+	setTagsOut(ctx, accessEntry.Tags)
+
+	return diags
+}
+
+func resourceAccessEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges("kubernetes_groups", "user_name") {
+		input := &eks.UpdateAccessEntryInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			KubernetesGroups:   flex.ExpandStringValueSet(d.Get("kubernetes_groups").(*schema.Set)),
+			PrincipalArn:       aws.String(principalARN),
+		}
+
+		if d.HasChange("user_name") {
+			input.Username = aws.String(d.Get("user_name").(string))
+		}
+
+		_, err := conn.UpdateAccessEntry(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EKS Access Entry (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAccessEntryRead(ctx, d, meta)...)
+}
+
+func resourceAccessEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, err := AccessEntryParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Access Entry: %s", d.Id())
+	_, err = conn.DeleteAccessEntry(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EKS Access Entry (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAccessEntryByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, principalARN string) (*types.AccessEntry, error) {
+	input := &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	output, err := conn.DescribeAccessEntry(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessEntry == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessEntry, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_eks_access_policies")
+func dataSourceAccessPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccessPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	input := &eks.ListAccessPoliciesInput{}
+	var accessPolicies []types.AccessPolicy
+	pages := eks.NewListAccessPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Access Policies: %s", err)
+		}
+
+		accessPolicies = append(accessPolicies, page.AccessPolicies...)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
+	if err := d.Set("access_policies", flattenAccessPolicies(accessPolicies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_policies: %s", err)
+	}
+
+	return diags
+}
+
+func flattenAccessPolicies(apiObjects []types.AccessPolicy) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":  aws.ToString(apiObject.Arn),
+			"name": aws.ToString(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
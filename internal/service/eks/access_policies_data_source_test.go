@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceResourceName := "data.aws_eks_access_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPoliciesDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceResourceName, "access_policies.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceResourceName, "access_policies.*", map[string]string{
+						"name": "AmazonEKSClusterAdminPolicy",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccessPoliciesDataSourceConfig_basic = `
+data "aws_eks_access_policies" "test" {}
+`
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_eks_access_policy_association", name="Access Policy Association")
+func resourceAccessPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessPolicyAssociationCreate,
+		ReadWithoutTimeout:   resourceAccessPolicyAssociationRead,
+		DeleteWithoutTimeout: resourceAccessPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_scope": {
+				Type:     schema.TypeList,
+				MinItems: 1,
+				MaxItems: 1,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespaces": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AccessScopeType](),
+						},
+					},
+				},
+			},
+			"associated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccessPolicyAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	principalARN := d.Get("principal_arn").(string)
+	policyARN := d.Get("policy_arn").(string)
+	id := AccessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN)
+	input := &eks.AssociateAccessPolicyInput{
+		AccessScope:  expandAccessScope(d.Get("access_scope").([]interface{})),
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	// Retry for the access entry's eventual consistency.
+	_, err := tfresource.RetryWhenIsA[*types.ResourceNotFoundException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateAccessPolicy(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Access Policy Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceAccessPolicyAssociationRead(ctx, d, meta)...)
+}
+
+func resourceAccessPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, policyARN, err := AccessPolicyAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	associatedAccessPolicy, err := findAssociatedAccessPolicyByThreePartKey(ctx, conn, clusterName, principalARN, policyARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Policy Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Access Policy Association (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_scope", flattenAccessScope(associatedAccessPolicy.AccessScope)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_scope: %s", err)
+	}
+	d.Set("associated_at", aws.ToTime(associatedAccessPolicy.AssociatedAt).Format(time.RFC3339))
+	d.Set("cluster_name", clusterName)
+	d.Set("modified_at", aws.ToTime(associatedAccessPolicy.ModifiedAt).Format(time.RFC3339))
+	d.Set("policy_arn", associatedAccessPolicy.PolicyArn)
+	d.Set("principal_arn", principalARN)
+
+	return diags
+}
+
+func resourceAccessPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, policyARN, err := AccessPolicyAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Access Policy Association: %s", d.Id())
+	_, err = conn.DisassociateAccessPolicy(ctx, &eks.DisassociateAccessPolicyInput{
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EKS Access Policy Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAssociatedAccessPolicyByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, principalARN, policyARN string) (*types.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	pages := eks.NewListAssociatedAccessPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AssociatedAccessPolicies {
+			if aws.ToString(v.PolicyArn) == policyARN {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func expandAccessScope(tfList []interface{}) *types.AccessScope {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.AccessScope{}
+
+	if v, ok := tfMap["namespaces"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Namespaces = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = types.AccessScopeType(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccessScope(apiObject *types.AccessScope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"namespaces": apiObject.Namespaces,
+		"type":       string(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessPolicyAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var associatedAccessPolicy types.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &associatedAccessPolicy),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.namespaces.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.type", "cluster"),
+					resource.TestCheckResourceAttrSet(resourceName, "associated_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_eks_access_entry.test", "principal_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var associatedAccessPolicy types.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &associatedAccessPolicy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfeks.ResourceAccessPolicyAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociation_namespaces(t *testing.T) {
+	ctx := acctest.Context(t)
+	var associatedAccessPolicy types.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig_namespaces(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &associatedAccessPolicy),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.namespaces.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_scope.0.namespaces.*", "default"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_scope.0.namespaces.*", "kube-system"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.type", "namespace"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPolicyAssociationExists(ctx context.Context, n string, v *types.AssociatedAccessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Access Policy Association ID is set")
+		}
+
+		clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindAssociatedAccessPolicyByThreePartKey(ctx, conn, clusterName, principalARN, policyARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAccessPolicyAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_access_policy_association" {
+				continue
+			}
+
+			clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfeks.FindAssociatedAccessPolicyByThreePartKey(ctx, conn, clusterName, principalARN, policyARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EKS Access Policy Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccessPolicyAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_basic(rName), `
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+  principal_arn = aws_eks_access_entry.test.principal_arn
+
+  access_scope {
+    type = "cluster"
+  }
+}
+`)
+}
+
+func testAccAccessPolicyAssociationConfig_namespaces(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_basic(rName), `
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+  principal_arn = aws_eks_access_entry.test.principal_arn
+
+  access_scope {
+    namespaces = ["default", "kube-system"]
+    type       = "namespace"
+  }
+}
+`)
+}
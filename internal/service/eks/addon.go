@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_eks_addon", name="Add-On")
 // @Tags(identifierAttribute="arn")
+// @ImportByARN(service="eks", resource="addon/{cluster_name}/{addon_name}/{}", id="{cluster_name}:{addon_name}")
 func resourceAddon() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAddonCreate,
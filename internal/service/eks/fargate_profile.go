@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_eks_fargate_profile", name="Fargate Profile")
 // @Tags(identifierAttribute="arn")
+// @ImportByARN(service="eks", resource="fargateprofile/{cluster_name}/{fargate_profile_name}/{}", id="{cluster_name}:{fargate_profile_name}")
 func resourceFargateProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFargateProfileCreate,
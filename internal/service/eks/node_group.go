@@ -33,6 +33,7 @@ import (
 
 // @SDKResource("aws_eks_node_group", name="Node Group")
 // @Tags(identifierAttribute="arn")
+// @ImportByARN(service="eks", resource="nodegroup/{cluster_name}/{node_group_name}/{}", id="{cluster_name}:{node_group_name}")
 func resourceNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNodeGroupCreate,
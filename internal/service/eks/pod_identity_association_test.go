@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSPodIdentityAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association types.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName, "pod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "association_arn", "eks", regexache.MustCompile(fmt.Sprintf("podidentityassociation/%s/.+", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.pod", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "service_account", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association types.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName, "pod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfeks.ResourcePodIdentityAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_updateRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association1, association2 types.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName, "pod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association1),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.pod", "arn"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName, "pod2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association2),
+					testAccCheckPodIdentityAssociationNotRecreated(&association1, &association2),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.pod2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association types.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPodIdentityAssociationExists(ctx context.Context, n string, v *types.PodIdentityAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Pod Identity Association ID is set")
+		}
+
+		clusterName, associationID, err := tfeks.PodIdentityAssociationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_pod_identity_association" {
+				continue
+			}
+
+			clusterName, associationID, err := tfeks.PodIdentityAssociationParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfeks.FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EKS Pod Identity Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationNotRecreated(i, j *types.PodIdentityAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.AssociationId) != aws.ToString(j.AssociationId) {
+			return fmt.Errorf("EKS Pod Identity Association recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccPodIdentityAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "pod_identity_assume_role" {
+  statement {
+    actions = ["sts:AssumeRole", "sts:TagSession"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["pods.eks.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "pod" {
+  name               = "%[1]s-pod-1"
+  assume_role_policy = data.aws_iam_policy_document.pod_identity_assume_role.json
+}
+
+resource "aws_iam_role" "pod2" {
+  name               = "%[1]s-pod-2"
+  assume_role_policy = data.aws_iam_policy_document.pod_identity_assume_role.json
+}
+`, rName))
+}
+
+func testAccPodIdentityAssociationConfig_basic(rName, roleResourceName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = %[1]q
+  role_arn        = aws_iam_role.%[2]s.arn
+}
+`, rName, roleResourceName))
+}
+
+func testAccPodIdentityAssociationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = %[1]q
+  role_arn        = aws_iam_role.pod.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPodIdentityAssociationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = %[1]q
+  role_arn        = aws_iam_role.pod.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -24,6 +24,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccessPolicies,
+			TypeName: "aws_eks_access_policies",
+		},
 		{
 			Factory:  dataSourceAddon,
 			TypeName: "aws_eks_addon",
@@ -57,6 +61,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAccessEntry,
+			TypeName: "aws_eks_access_entry",
+			Name:     "Access Entry",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "access_entry_arn",
+			},
+		},
+		{
+			Factory:  resourceAccessPolicyAssociation,
+			TypeName: "aws_eks_access_policy_association",
+			Name:     "Access Policy Association",
+		},
 		{
 			Factory:  resourceAddon,
 			TypeName: "aws_eks_addon",
@@ -64,6 +81,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "addon/{cluster_name}/{addon_name}/{}",
+				ID:       "{cluster_name}:{addon_name}",
+			},
 		},
 		{
 			Factory:  resourceCluster,
@@ -72,6 +94,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "cluster/{name}",
+			},
 		},
 		{
 			Factory:  resourceFargateProfile,
@@ -80,6 +106,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "fargateprofile/{cluster_name}/{fargate_profile_name}/{}",
+				ID:       "{cluster_name}:{fargate_profile_name}",
+			},
 		},
 		{
 			Factory:  resourceIdentityProviderConfig,
@@ -96,6 +127,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "eks",
+				Resource: "nodegroup/{cluster_name}/{node_group_name}/{}",
+				ID:       "{cluster_name}:{node_group_name}",
+			},
+		},
+		{
+			Factory:  resourcePodIdentityAssociation,
+			TypeName: "aws_eks_pod_identity_association",
+			Name:     "Pod Identity Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "association_arn",
+			},
 		},
 	}
 }
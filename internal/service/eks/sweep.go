@@ -30,6 +30,7 @@ func RegisterSweepers() {
 			"aws_eks_addon",
 			"aws_eks_fargate_profile",
 			"aws_eks_node_group",
+			"aws_eks_pod_identity_association",
 			"aws_emrcontainers_virtual_cluster",
 		},
 	})
@@ -48,6 +49,11 @@ func RegisterSweepers() {
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
+
+	resource.AddTestSweepers("aws_eks_pod_identity_association", &resource.Sweeper{
+		Name: "aws_eks_pod_identity_association",
+		F:    sweepPodIdentityAssociations,
+	})
 }
 
 func sweepAddons(region string) error {
@@ -370,3 +376,73 @@ func sweepNodeGroups(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPodIdentityAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.EKSClient(ctx)
+	input := &eks.ListClustersInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := eks.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EKS Pod Identity Association sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Clusters (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Clusters {
+			clusterName := v
+			input := &eks.ListPodIdentityAssociationsInput{
+				ClusterName: aws.String(clusterName),
+			}
+
+			pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if awsv2.SkipSweepError(err) {
+					continue
+				}
+
+				// There are EKS clusters that are listed (and are in the AWS Console) but can't be found.
+				// ¯\_(ツ)_/¯
+				if errs.IsA[*types.ResourceNotFoundException](err) {
+					continue
+				}
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Pod Identity Associations (%s): %w", region, err))
+					break
+				}
+
+				for _, v := range page.Associations {
+					r := resourcePodIdentityAssociation()
+					d := r.Data(nil)
+					d.SetId(PodIdentityAssociationCreateResourceID(clusterName, aws.ToString(v.AssociationId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EKS Pod Identity Associations (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
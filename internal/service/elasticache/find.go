@@ -296,3 +296,29 @@ func FindCacheSubnetGroupByName(ctx context.Context, conn *elasticache.ElastiCac
 
 	return output.CacheSubnetGroups[0], nil
 }
+
+// findServerlessCacheByID retrieves an ElastiCache Serverless Cache by name.
+func findServerlessCacheByID(ctx context.Context, conn *elasticache.ElastiCache, id string) (*elasticache.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(id),
+	}
+	output, err := conn.DescribeServerlessCachesWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCaches) == 0 || output.ServerlessCaches[0] == nil {
+		return nil, &retry.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ServerlessCaches[0], nil
+}
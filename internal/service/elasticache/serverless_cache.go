@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Serverless Cache")
+// @Tags(identifierAttribute="arn")
+func newResourceServerlessCache(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceServerlessCache{}
+
+	r.SetDefaultCreateTimeout(40 * time.Minute)
+	r.SetDefaultUpdateTimeout(80 * time.Minute)
+	r.SetDefaultDeleteTimeout(40 * time.Minute)
+
+	return r, nil
+}
+
+type resourceServerlessCache struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceServerlessCache) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_elasticache_serverless_cache"
+}
+
+func (r *resourceServerlessCache) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	endpointAttribute := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+			ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"create_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"daily_snapshot_time": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": endpointAttribute(),
+			"engine": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_engine_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"major_engine_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reader_endpoint": endpointAttribute(),
+			"security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_arns_to_restore": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_retention_limit": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(35),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"user_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cache_usage_limits": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cacheUsageLimitsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"data_storage": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataStorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"maximum": schema.Int64Attribute{
+										Required: true,
+									},
+									"unit": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(elasticache.DataStorageUnit_Values()...),
+										},
+									},
+								},
+							},
+						},
+						"ecpu_per_second": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ecpuPerSecondModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"maximum": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1000, 15000000),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceServerlessCache) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serverlessCacheResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheConn(ctx)
+
+	input := &elasticache.CreateServerlessCacheInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateServerlessCacheWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ElastiCache Serverless Cache (%s)", data.ServerlessCacheName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	serverlessCache, err := waitServerlessCacheAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, serverlessCache)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceServerlessCache) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serverlessCacheResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().ElastiCacheConn(ctx)
+
+	serverlessCache, err := findServerlessCacheByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, serverlessCache)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceServerlessCache) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serverlessCacheResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheConn(ctx)
+
+	if serverlessCacheHasChanges(ctx, new, old) {
+		input := &elasticache.ModifyServerlessCacheInput{
+			ServerlessCacheName: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.CacheUsageLimits.Equal(old.CacheUsageLimits) {
+			response.Diagnostics.Append(flex.Expand(ctx, new.CacheUsageLimits, &input.CacheUsageLimits)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.DailySnapshotTime.Equal(old.DailySnapshotTime) {
+			input.DailySnapshotTime = flex.StringFromFramework(ctx, new.DailySnapshotTime)
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.SecurityGroupIDs.Equal(old.SecurityGroupIDs) {
+			input.SecurityGroupIds = flex.ExpandFrameworkStringSet(ctx, new.SecurityGroupIDs)
+		}
+
+		if !new.SnapshotRetentionLimit.Equal(old.SnapshotRetentionLimit) {
+			input.SnapshotRetentionLimit = flex.Int64FromFramework(ctx, new.SnapshotRetentionLimit)
+		}
+
+		if !new.UserGroupID.Equal(old.UserGroupID) {
+			if new.UserGroupID.IsNull() {
+				input.RemoveUserGroup = aws.Bool(true)
+			} else {
+				input.UserGroupId = flex.StringFromFramework(ctx, new.UserGroupID)
+			}
+		}
+
+		_, err := conn.ModifyServerlessCacheWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ElastiCache Serverless Cache (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		serverlessCache, err := waitServerlessCacheAvailable(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, serverlessCache)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceServerlessCache) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serverlessCacheResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheConn(ctx)
+
+	_, err := conn.DeleteServerlessCacheWithContext(ctx, &elasticache.DeleteServerlessCacheInput{
+		ServerlessCacheName: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ElastiCache Serverless Cache (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitServerlessCacheDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceServerlessCache) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceServerlessCache) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func serverlessCacheHasChanges(_ context.Context, plan, state serverlessCacheResourceModel) bool {
+	return !plan.CacheUsageLimits.Equal(state.CacheUsageLimits) ||
+		!plan.DailySnapshotTime.Equal(state.DailySnapshotTime) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.SecurityGroupIDs.Equal(state.SecurityGroupIDs) ||
+		!plan.SnapshotRetentionLimit.Equal(state.SnapshotRetentionLimit) ||
+		!plan.UserGroupID.Equal(state.UserGroupID)
+}
+
+type serverlessCacheResourceModel struct {
+	ARN                    types.String                                           `tfsdk:"arn"`
+	CacheUsageLimits       fwtypes.ListNestedObjectValueOf[cacheUsageLimitsModel] `tfsdk:"cache_usage_limits"`
+	CreateTime             types.String                                           `tfsdk:"create_time"`
+	DailySnapshotTime      types.String                                           `tfsdk:"daily_snapshot_time"`
+	Description            types.String                                           `tfsdk:"description"`
+	Endpoint               fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"endpoint"`
+	Engine                 types.String                                           `tfsdk:"engine"`
+	FullEngineVersion      types.String                                           `tfsdk:"full_engine_version"`
+	ID                     types.String                                           `tfsdk:"id"`
+	KmsKeyID               types.String                                           `tfsdk:"kms_key_id"`
+	MajorEngineVersion     types.String                                           `tfsdk:"major_engine_version"`
+	ReaderEndpoint         fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"reader_endpoint"`
+	SecurityGroupIDs       types.Set                                              `tfsdk:"security_group_ids"`
+	ServerlessCacheName    types.String                                           `tfsdk:"name"`
+	SnapshotARNsToRestore  types.List                                             `tfsdk:"snapshot_arns_to_restore"`
+	SnapshotRetentionLimit types.Int64                                            `tfsdk:"snapshot_retention_limit"`
+	Status                 types.String                                           `tfsdk:"status"`
+	SubnetIDs              types.Set                                              `tfsdk:"subnet_ids"`
+	Tags                   types.Map                                              `tfsdk:"tags"`
+	TagsAll                types.Map                                              `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value                                         `tfsdk:"timeouts"`
+	UserGroupID            types.String                                           `tfsdk:"user_group_id"`
+}
+
+func (data *serverlessCacheResourceModel) InitFromID() {
+	data.ServerlessCacheName = data.ID
+}
+
+func (data *serverlessCacheResourceModel) setID() {
+	data.ID = data.ServerlessCacheName
+}
+
+func (data *serverlessCacheResourceModel) refreshFromOutput(ctx context.Context, apiObject *elasticache.ServerlessCache) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Usage limits are reported as an empty object when none were configured.
+	if v := apiObject.CacheUsageLimits; v != nil && v.DataStorage == nil && v.ECPUPerSecond == nil {
+		apiObject.CacheUsageLimits = nil
+	}
+
+	diags.Append(flex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.CreateTime = flex.StringValueToFramework(ctx, aws.TimeValue(apiObject.CreateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+type cacheUsageLimitsModel struct {
+	DataStorage   fwtypes.ListNestedObjectValueOf[dataStorageModel]   `tfsdk:"data_storage"`
+	ECPUPerSecond fwtypes.ListNestedObjectValueOf[ecpuPerSecondModel] `tfsdk:"ecpu_per_second"`
+}
+
+type dataStorageModel struct {
+	Maximum types.Int64  `tfsdk:"maximum"`
+	Unit    types.String `tfsdk:"unit"`
+}
+
+type ecpuPerSecondModel struct {
+	Maximum types.Int64 `tfsdk:"maximum"`
+}
+
+type endpointModel struct {
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
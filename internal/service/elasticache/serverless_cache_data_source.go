@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Serverless Cache")
+func newDataSourceServerlessCache(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceServerlessCache{}, nil
+}
+
+type dataSourceServerlessCache struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceServerlessCache) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_elasticache_serverless_cache"
+}
+
+func (d *dataSourceServerlessCache) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	endpointAttribute := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+			ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+			Computed:    true,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"cache_usage_limits": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[cacheUsageLimitsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[cacheUsageLimitsModel](ctx),
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"daily_snapshot_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"endpoint": endpointAttribute(),
+			"engine": schema.StringAttribute{
+				Computed: true,
+			},
+			"full_engine_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"major_engine_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"reader_endpoint": endpointAttribute(),
+			"security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"snapshot_retention_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"user_group_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceServerlessCache) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serverlessCacheDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ElastiCacheConn(ctx)
+
+	name := data.ServerlessCacheName.ValueString()
+	serverlessCache, err := findServerlessCacheByID(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, serverlessCache, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.CreateTime = flex.StringValueToFramework(ctx, aws.TimeValue(serverlessCache.CreateTime).Format(time.RFC3339))
+	data.ID = flex.StringToFramework(ctx, serverlessCache.ServerlessCacheName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type serverlessCacheDataSourceModel struct {
+	ARN                    types.String                                           `tfsdk:"arn"`
+	CacheUsageLimits       fwtypes.ListNestedObjectValueOf[cacheUsageLimitsModel] `tfsdk:"cache_usage_limits"`
+	CreateTime             types.String                                           `tfsdk:"create_time"`
+	DailySnapshotTime      types.String                                           `tfsdk:"daily_snapshot_time"`
+	Description            types.String                                           `tfsdk:"description"`
+	Endpoint               fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"endpoint"`
+	Engine                 types.String                                           `tfsdk:"engine"`
+	FullEngineVersion      types.String                                           `tfsdk:"full_engine_version"`
+	ID                     types.String                                           `tfsdk:"id"`
+	KmsKeyID               types.String                                           `tfsdk:"kms_key_id"`
+	MajorEngineVersion     types.String                                           `tfsdk:"major_engine_version"`
+	ReaderEndpoint         fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"reader_endpoint"`
+	SecurityGroupIDs       types.Set                                              `tfsdk:"security_group_ids"`
+	ServerlessCacheName    types.String                                           `tfsdk:"name"`
+	SnapshotRetentionLimit types.Int64                                            `tfsdk:"snapshot_retention_limit"`
+	Status                 types.String                                           `tfsdk:"status"`
+	SubnetIDs              types.Set                                              `tfsdk:"subnet_ids"`
+	UserGroupID            types.String                                           `tfsdk:"user_group_id"`
+}
@@ -129,3 +129,26 @@ func statusGlobalReplicationGroupMember(ctx context.Context, conn *elasticache.E
 		return member, aws.StringValue(member.Status), nil
 	}
 }
+
+const (
+	serverlessCacheStatusAvailable    = "available"
+	serverlessCacheStatusCreateFailed = "create-failed"
+	serverlessCacheStatusCreating     = "creating"
+	serverlessCacheStatusDeleting     = "deleting"
+	serverlessCacheStatusModifying    = "modifying"
+)
+
+// statusServerlessCache fetches the Serverless Cache and its Status
+func statusServerlessCache(ctx context.Context, conn *elasticache.ElastiCache, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		serverlessCache, err := findServerlessCacheByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return serverlessCache, aws.StringValue(serverlessCache.Status), nil
+	}
+}
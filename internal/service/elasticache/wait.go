@@ -234,3 +234,55 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 	}
 	return nil, err
 }
+
+const (
+	serverlessCacheAvailableMinTimeout = 10 * time.Second
+	serverlessCacheAvailableDelay      = 30 * time.Second
+
+	serverlessCacheDeletedMinTimeout = 10 * time.Second
+	serverlessCacheDeletedDelay      = 30 * time.Second
+)
+
+// waitServerlessCacheAvailable waits for a Serverless Cache to return Available
+func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			serverlessCacheStatusCreating,
+			serverlessCacheStatusModifying,
+		},
+		Target:     []string{serverlessCacheStatusAvailable},
+		Refresh:    statusServerlessCache(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheAvailableMinTimeout,
+		Delay:      serverlessCacheAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// waitServerlessCacheDeleted waits for a Serverless Cache to be deleted
+func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			serverlessCacheStatusCreating,
+			serverlessCacheStatusAvailable,
+			serverlessCacheStatusCreateFailed,
+			serverlessCacheStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    statusServerlessCache(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: serverlessCacheDeletedMinTimeout,
+		Delay:      serverlessCacheDeletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
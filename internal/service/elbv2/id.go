@@ -5,6 +5,7 @@ package elbv2
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -24,3 +25,25 @@ func listenerCertificateParseID(id string) (string, string, error) {
 func listenerCertificateCreateID(listenerArn, certificateArn string) string {
 	return strings.Join([]string{listenerArn, listenerCertificateIDSeparator, certificateArn}, "")
 }
+
+const trustStoreRevocationIDSeparator = ","
+
+func trustStoreRevocationCreateResourceID(trustStoreARN string, revocationID int64) string {
+	return strings.Join([]string{trustStoreARN, strconv.FormatInt(revocationID, 10)}, trustStoreRevocationIDSeparator)
+}
+
+func TrustStoreRevocationParseResourceID(id string) (string, int64, error) {
+	parts := strings.Split(id, trustStoreRevocationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		revocationID, err := strconv.ParseInt(parts[1], 10, 64)
+
+		if err != nil {
+			return "", 0, fmt.Errorf("parsing revocation ID (%s): %w", parts[1], err)
+		}
+
+		return parts[0], revocationID, nil
+	}
+
+	return "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected TRUST_STORE_ARN%[2]sREVOCATION_ID", id, trustStoreRevocationIDSeparator)
+}
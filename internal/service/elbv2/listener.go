@@ -355,6 +355,30 @@ func ResourceListener() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mutualAuthenticationMode_Values(), true),
+						},
+						"trust_store_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -425,6 +449,10 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MutualAuthentication = expandMutualAuthenticationAttributes(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("port"); ok {
 		input.Port = aws.Int64(int64(v.(int)))
 	}
@@ -549,6 +577,10 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting default_action for ELBv2 listener (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("mutual_authentication", flattenMutualAuthenticationAttributes(listener.MutualAuthentication)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mutual_authentication: %s", err)
+	}
+
 	return diags
 }
 
@@ -595,6 +627,10 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
+		if d.HasChange("mutual_authentication") {
+			input.MutualAuthentication = expandMutualAuthenticationAttributes(d.Get("mutual_authentication").([]interface{}))
+		}
+
 		err := retry.RetryContext(ctx, loadBalancerListenerUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.ModifyListenerWithContext(ctx, input)
 
@@ -672,6 +708,56 @@ func retryListenerCreate(ctx context.Context, conn *elbv2.ELBV2, params *elbv2.C
 	return output, nil
 }
 
+func expandMutualAuthenticationAttributes(tfList []interface{}) *elbv2.MutualAuthenticationAttributes {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &elbv2.MutualAuthenticationAttributes{
+			Mode: aws.String(mutualAuthenticationModeOff),
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &elbv2.MutualAuthenticationAttributes{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+
+		if v == mutualAuthenticationModeOff || v == mutualAuthenticationModePassthrough {
+			return apiObject
+		}
+	}
+
+	if v, ok := tfMap["ignore_client_certificate_expiry"].(bool); ok {
+		apiObject.IgnoreClientCertificateExpiry = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["trust_store_arn"].(string); ok && v != "" {
+		apiObject.TrustStoreArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMutualAuthenticationAttributes(apiObject *elbv2.MutualAuthenticationAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": aws.StringValue(apiObject.Mode),
+	}
+
+	if v := apiObject.IgnoreClientCertificateExpiry; v != nil {
+		tfMap["ignore_client_certificate_expiry"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.TrustStoreArn; v != nil {
+		tfMap["trust_store_arn"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandLbListenerActions(l []interface{}) ([]*elbv2.Action, error) {
 	if len(l) == 0 {
 		return nil, nil
@@ -257,6 +257,26 @@ func DataSourceListener() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"arn"},
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_store_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -351,6 +371,10 @@ func dataSourceListenerRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "setting default_action: %s", err)
 	}
 
+	if err := d.Set("mutual_authentication", flattenMutualAuthenticationAttributes(listener.MutualAuthentication)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mutual_authentication: %s", err)
+	}
+
 	tags, err := listTags(ctx, conn, d.Id())
 
 	if errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lb_trust_store_revocation", name="Trust Store Revocation")
+func ResourceTrustStoreRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreRevocationCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRevocationRead,
+		DeleteWithoutTimeout: resourceTrustStoreRevocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revocation_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"revocations_s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revocations_s3_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revocations_s3_object_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTrustStoreRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	trustStoreARN := d.Get("trust_store_arn").(string)
+	s3Bucket := d.Get("revocations_s3_bucket").(string)
+	s3Key := d.Get("revocations_s3_key").(string)
+	input := &elbv2.AddTrustStoreRevocationsInput{
+		RevocationContents: []*elbv2.RevocationContent{{
+			S3Bucket: aws.String(s3Bucket),
+			S3Key:    aws.String(s3Key),
+		}},
+		TrustStoreArn: aws.String(trustStoreARN),
+	}
+
+	if v, ok := d.GetOk("revocations_s3_object_version"); ok {
+		input.RevocationContents[0].S3ObjectVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.AddTrustStoreRevocationsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELBv2 Trust Store (%s) Revocation (s3://%s/%s): %s", trustStoreARN, s3Bucket, s3Key, err)
+	}
+
+	if output == nil || len(output.TrustStoreRevocations) == 0 {
+		return sdkdiag.AppendErrorf(diags, "creating ELBv2 Trust Store (%s) Revocation (s3://%s/%s): empty result", trustStoreARN, s3Bucket, s3Key)
+	}
+
+	d.SetId(trustStoreRevocationCreateResourceID(trustStoreARN, aws.Int64Value(output.TrustStoreRevocations[0].RevocationId)))
+
+	if err := waitTrustStoreActive(ctx, conn, trustStoreARN, propagationTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Trust Store (%s) update: %s", trustStoreARN, err)
+	}
+
+	return append(diags, resourceTrustStoreRevocationRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	trustStoreARN, revocationID, err := TrustStoreRevocationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindTrustStoreRevocationByTwoPartKey(ctx, conn, trustStoreARN, revocationID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ELBv2 Trust Store Revocation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store Revocation (%s): %s", d.Id(), err)
+	}
+
+	revocation := outputRaw.(*elbv2.DescribeTrustStoreRevocation)
+
+	d.Set("revocation_id", revocation.RevocationId)
+	d.Set("trust_store_arn", revocation.TrustStoreArn)
+
+	return diags
+}
+
+func resourceTrustStoreRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	trustStoreARN, revocationID, err := TrustStoreRevocationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ELBv2 Trust Store Revocation: %s", d.Id())
+	_, err = conn.RemoveTrustStoreRevocationsWithContext(ctx, &elbv2.RemoveTrustStoreRevocationsInput{
+		RevocationIds: aws.Int64Slice([]int64{revocationID}),
+		TrustStoreArn: aws.String(trustStoreARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeRevocationIdNotFoundException, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Trust Store Revocation (%s): %s", d.Id(), err)
+	}
+
+	if err := waitTrustStoreActive(ctx, conn, trustStoreARN, propagationTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Trust Store (%s) update: %s", trustStoreARN, err)
+	}
+
+	return diags
+}
+
+func FindTrustStoreRevocationByTwoPartKey(ctx context.Context, conn *elbv2.ELBV2, trustStoreARN string, revocationID int64) (*elbv2.DescribeTrustStoreRevocation, error) {
+	input := &elbv2.DescribeTrustStoreRevocationsInput{
+		RevocationIds: aws.Int64Slice([]int64{revocationID}),
+		TrustStoreArn: aws.String(trustStoreARN),
+	}
+
+	output, err := findTrustStoreRevocation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.Int64Value(output.RevocationId) != revocationID {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStoreRevocation(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeTrustStoreRevocationsInput) (*elbv2.DescribeTrustStoreRevocation, error) {
+	output, err := findTrustStoreRevocations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func findTrustStoreRevocations(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeTrustStoreRevocationsInput) ([]*elbv2.DescribeTrustStoreRevocation, error) {
+	var output []*elbv2.DescribeTrustStoreRevocation
+
+	err := conn.DescribeTrustStoreRevocationsPagesWithContext(ctx, input, func(page *elbv2.DescribeTrustStoreRevocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStoreRevocations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeRevocationIdNotFoundException, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
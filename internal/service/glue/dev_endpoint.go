@@ -290,7 +290,7 @@ func resourceDevEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	endpointARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("devEndpoint/%s", d.Id()),
 	}.String()
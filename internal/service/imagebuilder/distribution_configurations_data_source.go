@@ -74,7 +74,7 @@ func dataSourceDistributionConfigurationsRead(ctx context.Context, d *schema.Res
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
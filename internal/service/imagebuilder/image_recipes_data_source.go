@@ -84,7 +84,7 @@ func dataSourceImageRecipesRead(ctx context.Context, d *schema.ResourceData, met
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
@@ -194,7 +194,7 @@ func resourceIndexingConfigurationPut(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("updating IoT Indexing Configuration: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 
 	return resourceIndexingConfigurationRead(ctx, d, meta)
 }
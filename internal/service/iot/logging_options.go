@@ -71,7 +71,7 @@ func resourceLoggingOptionsPut(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("setting IoT logging options: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 
 	return resourceLoggingOptionsRead(ctx, d, meta)
 }
@@ -116,7 +116,7 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 		plaintext[name] = string(output.Plaintext)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("plaintext", plaintext)
 
 	return nil
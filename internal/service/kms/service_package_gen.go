@@ -89,6 +89,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "kms",
+				Resource: "key/{key_id}",
+			},
 		},
 		{
 			Factory:  ResourceKeyPolicy,
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+// Exports for use in tests only.
+var (
+	ResourceFunctionRecursionConfig = newResourceFunctionRecursionConfig
+	ResourceRuntimeManagementConfig = newResourceRuntimeManagementConfig
+
+	FindFunctionRecursionConfigByName       = findFunctionRecursionConfigByName
+	FindRuntimeManagementConfigByTwoPartKey = findRuntimeManagementConfigByTwoPartKey
+)
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Function Recursion Config")
+func newResourceFunctionRecursionConfig(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFunctionRecursionConfig{}, nil
+}
+
+type resourceFunctionRecursionConfig struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceFunctionRecursionConfig) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_function_recursion_config"
+}
+
+func (r *resourceFunctionRecursionConfig) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"recursive_loop": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecursiveLoop](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *resourceFunctionRecursionConfig) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data functionRecursionConfigResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	data.setID()
+
+	input := &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  flex.StringFromFramework(ctx, data.FunctionName),
+		RecursiveLoop: data.RecursiveLoop.ValueEnum(),
+	}
+
+	_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lambda Function Recursion Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFunctionRecursionConfig) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data functionRecursionConfigResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	output, err := findFunctionRecursionConfigByName(ctx, conn, data.FunctionName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lambda Function Recursion Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.RecursiveLoop = fwtypes.StringEnumValue(output.RecursiveLoop)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFunctionRecursionConfig) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data functionRecursionConfigResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	input := &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  flex.StringFromFramework(ctx, data.FunctionName),
+		RecursiveLoop: data.RecursiveLoop.ValueEnum(),
+	}
+
+	_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Lambda Function Recursion Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFunctionRecursionConfig) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data functionRecursionConfigResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	// There is no API to delete a function recursion configuration.
+	// Restore the default recursive loop detection instead.
+	input := &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  flex.StringFromFramework(ctx, data.FunctionName),
+		RecursiveLoop: awstypes.RecursiveLoopTerminate,
+	}
+
+	_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lambda Function Recursion Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findFunctionRecursionConfigByName(ctx context.Context, conn *lambda.Client, name string) (*lambda.GetFunctionRecursionConfigOutput, error) {
+	input := &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetFunctionRecursionConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type functionRecursionConfigResourceModel struct {
+	FunctionName  types.String                               `tfsdk:"function_name"`
+	ID            types.String                               `tfsdk:"id"`
+	RecursiveLoop fwtypes.StringEnum[awstypes.RecursiveLoop] `tfsdk:"recursive_loop"`
+}
+
+func (data *functionRecursionConfigResourceModel) InitFromID() {
+	data.FunctionName = data.ID
+}
+
+func (data *functionRecursionConfigResourceModel) setID() {
+	data.ID = data.FunctionName
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionRecursionConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetFunctionRecursionConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_recursion_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionRecursionConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(awstypes.RecursiveLoopAllow)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopAllow)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(awstypes.RecursiveLoopTerminate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopTerminate)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionRecursionConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetFunctionRecursionConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_recursion_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionRecursionConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(awstypes.RecursiveLoopAllow)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunction(), functionResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionRecursionConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_recursion_config" {
+				continue
+			}
+
+			output, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, rs.Primary.Attributes["function_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleting the resource restores the default recursive loop detection.
+			if output.RecursiveLoop == awstypes.RecursiveLoopTerminate {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Function Recursion Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionRecursionConfigExists(ctx context.Context, n string, v *lambda.GetFunctionRecursionConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, rs.Primary.Attributes["function_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFunctionRecursionConfigConfig_basic(rName, recursiveLoop string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_function_recursion_config" "test" {
+  function_name  = aws_lambda_function.test.function_name
+  recursive_loop = %[1]q
+}
+`, recursiveLoop))
+}
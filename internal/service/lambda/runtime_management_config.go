@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Runtime Management Config")
+func newResourceRuntimeManagementConfig(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRuntimeManagementConfig{}, nil
+}
+
+type resourceRuntimeManagementConfig struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceRuntimeManagementConfig) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_runtime_management_config"
+}
+
+func (r *resourceRuntimeManagementConfig) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	updateRuntimeOnType := fwtypes.StringEnumType[awstypes.UpdateRuntimeOn]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_arn": framework.ARNAttributeComputedOnly(),
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_version_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"update_runtime_on": schema.StringAttribute{
+				CustomType: updateRuntimeOnType,
+				Optional:   true,
+				Computed:   true,
+				Default:    updateRuntimeOnType.AttributeDefault(awstypes.UpdateRuntimeOnAuto),
+			},
+		},
+	}
+}
+
+func (r *resourceRuntimeManagementConfig) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data runtimeManagementConfigResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	data.setID()
+
+	output, err := putRuntimeManagementConfig(ctx, conn, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.FunctionARN = flex.StringToFramework(ctx, output.FunctionArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRuntimeManagementConfig) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data runtimeManagementConfigResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	output, err := findRuntimeManagementConfigByTwoPartKey(ctx, conn, data.FunctionName.ValueString(), data.Qualifier.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.FunctionARN = flex.StringToFramework(ctx, output.FunctionArn)
+	data.RuntimeVersionARN = flex.StringToFrameworkARN(ctx, output.RuntimeVersionArn)
+	data.UpdateRuntimeOn = fwtypes.StringEnumValue(output.UpdateRuntimeOn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRuntimeManagementConfig) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data runtimeManagementConfigResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	output, err := putRuntimeManagementConfig(ctx, conn, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.FunctionARN = flex.StringToFramework(ctx, output.FunctionArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRuntimeManagementConfig) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data runtimeManagementConfigResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	// There is no API to delete a runtime management configuration.
+	// Restore the default update mode instead.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    flex.StringFromFramework(ctx, data.FunctionName),
+		Qualifier:       flex.StringFromFramework(ctx, data.Qualifier),
+		UpdateRuntimeOn: awstypes.UpdateRuntimeOnAuto,
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putRuntimeManagementConfig(ctx context.Context, conn *lambda.Client, data *runtimeManagementConfigResourceModel) (*lambda.PutRuntimeManagementConfigOutput, error) {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:      flex.StringFromFramework(ctx, data.FunctionName),
+		Qualifier:         flex.StringFromFramework(ctx, data.Qualifier),
+		RuntimeVersionArn: flex.StringFromFramework(ctx, data.RuntimeVersionARN),
+		UpdateRuntimeOn:   data.UpdateRuntimeOn.ValueEnum(),
+	}
+
+	return conn.PutRuntimeManagementConfig(ctx, input)
+}
+
+func findRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const runtimeManagementConfigResourceIDSeparator = ","
+
+type runtimeManagementConfigResourceModel struct {
+	FunctionARN       types.String                                 `tfsdk:"function_arn"`
+	FunctionName      types.String                                 `tfsdk:"function_name"`
+	ID                types.String                                 `tfsdk:"id"`
+	Qualifier         types.String                                 `tfsdk:"qualifier"`
+	RuntimeVersionARN fwtypes.ARN                                  `tfsdk:"runtime_version_arn"`
+	UpdateRuntimeOn   fwtypes.StringEnum[awstypes.UpdateRuntimeOn] `tfsdk:"update_runtime_on"`
+}
+
+func (data *runtimeManagementConfigResourceModel) InitFromID() error {
+	parts := strings.Split(data.ID.ValueString(), runtimeManagementConfigResourceIDSeparator)
+
+	switch len(parts) {
+	case 1:
+		if parts[0] != "" {
+			data.FunctionName = types.StringValue(parts[0])
+			data.Qualifier = types.StringNull()
+
+			return nil
+		}
+	case 2:
+		if parts[0] != "" && parts[1] != "" {
+			data.FunctionName = types.StringValue(parts[0])
+			data.Qualifier = types.StringValue(parts[1])
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", data.ID.ValueString(), runtimeManagementConfigResourceIDSeparator)
+}
+
+func (data *runtimeManagementConfigResourceModel) setID() {
+	parts := []string{data.FunctionName.ValueString()}
+	if qualifier := data.Qualifier.ValueString(); qualifier != "" {
+		parts = append(parts, qualifier)
+	}
+
+	data.ID = types.StringValue(strings.Join(parts, runtimeManagementConfigResourceIDSeparator))
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "runtime_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(awstypes.UpdateRuntimeOnAuto)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnAuto)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunction(), functionResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_manual(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Runtime version ARNs are published per Region and runtime, e.g.
+	// arn:aws:lambda:us-west-2::runtime:<hash> for nodejs20.x.
+	runtimeVersionARN := acctest.SkipIfEnvVarNotSet(t, "LAMBDA_RUNTIME_VERSION_ARN")
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_manual(rName, runtimeVersionARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", "$LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", runtimeVersionARN),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnManual)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_runtime_management_config" {
+				continue
+			}
+
+			output, err := tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleting the resource restores the default update mode.
+			if output.UpdateRuntimeOn == awstypes.UpdateRuntimeOnAuto {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Runtime Management Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeManagementConfigExists(ctx context.Context, n string, v *lambda.GetRuntimeManagementConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuntimeManagementConfigConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+`, rName)
+}
+
+func testAccRuntimeManagementConfigConfig_basic(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[1]q
+}
+`, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_manual(rName, runtimeVersionARN string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name       = aws_lambda_function.test.function_name
+  qualifier           = "$LATEST"
+  runtime_version_arn = %[1]q
+  update_runtime_on   = "Manual"
+}
+`, runtimeVersionARN))
+}
@@ -57,7 +57,7 @@ func dataSourceDistributedGrantsRead(ctx context.Context, d *schema.ResourceData
 		grantARNs = append(grantARNs, aws.StringValue(v.GrantArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", grantARNs)
 
 	return diags
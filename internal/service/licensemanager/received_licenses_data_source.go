@@ -57,7 +57,7 @@ func dataSourceReceivedLicensesRead(ctx context.Context, d *schema.ResourceData,
 		licenseARNs = append(licenseARNs, aws.StringValue(v.LicenseArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("arns", licenseARNs)
 
 	return diags
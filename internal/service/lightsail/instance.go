@@ -159,13 +159,14 @@ func ResourceInstance() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 		CustomizeDiff: customdiff.All(
-			customdiff.ValidateChange("availability_zone", func(ctx context.Context, old, new, meta any) error {
-				// The availability_zone must be in the same region as the provider region
-				if !strings.HasPrefix(new.(string), meta.(*conns.AWSClient).Region) {
-					return fmt.Errorf("availability_zone must be within the same region as provider region: %s", meta.(*conns.AWSClient).Region)
+			func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+				// The availability_zone must be in the same region as the resource's region
+				region := meta.(*conns.AWSClient).ResourceRegion(diff)
+				if !strings.HasPrefix(diff.Get("availability_zone").(string), region) {
+					return fmt.Errorf("availability_zone must be within the same region as the resource region: %s", region)
 				}
 				return nil
-			}),
+			},
 			verify.SetTagsDiff,
 		),
 	}
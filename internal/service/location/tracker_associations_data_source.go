@@ -71,7 +71,7 @@ func dataSourceTrackerAssociationsRead(ctx context.Context, d *schema.ResourceDa
 		return create.DiagError(names.Location, create.ErrActionReading, DSNameTrackerAssociations, name, err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("consumer_arns", arns)
 
 	return nil
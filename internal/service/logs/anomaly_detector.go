@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func newResourceAnomalyDetector(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAnomalyDetector{}, nil
+}
+
+type resourceAnomalyDetector struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAnomalyDetector) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_anomaly_detector"
+}
+
+func (r *resourceAnomalyDetector) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anomaly_visibility_time": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(7, 90),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"detector_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"evaluation_frequency": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.EvaluationFrequency](),
+				},
+			},
+			"filter_pattern": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_group_arn_list": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *resourceAnomalyDetector) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data anomalyDetectorResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.CreateLogAnomalyDetectorInput{
+		AnomalyVisibilityTime: flex.Int64FromFramework(ctx, data.AnomalyVisibilityTime),
+		DetectorName:          flex.StringFromFramework(ctx, data.DetectorName),
+		EvaluationFrequency:   awstypes.EvaluationFrequency(data.EvaluationFrequency.ValueString()),
+		FilterPattern:         flex.StringFromFramework(ctx, data.FilterPattern),
+		KmsKeyId:              flex.StringFromFramework(ctx, data.KMSKeyID),
+		LogGroupArnList:       flex.ExpandFrameworkStringValueSet(ctx, data.LogGroupARNList),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateLogAnomalyDetector(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudWatch Logs Anomaly Detector", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.AnomalyDetectorArn)
+	data.ARN = types.StringValue(arn)
+	data.setID()
+
+	// Anomaly detectors are always created enabled.
+	if !data.Enabled.ValueBool() {
+		if err := updateAnomalyDetector(ctx, conn, &data); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Anomaly Detector (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	detector, err := findAnomalyDetectorByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Anomaly Detector (%s)", arn), err.Error())
+
+		return
+	}
+
+	data.AnomalyVisibilityTime = flex.Int64ToFramework(ctx, detector.AnomalyVisibilityTime)
+	data.DetectorName = flex.StringToFramework(ctx, detector.DetectorName)
+	data.EvaluationFrequency = flex.StringValueToFramework(ctx, detector.EvaluationFrequency)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnomalyDetector) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data anomalyDetectorResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	output, err := findAnomalyDetectorByARN(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AnomalyVisibilityTime = flex.Int64ToFramework(ctx, output.AnomalyVisibilityTime)
+	data.DetectorName = flex.StringToFramework(ctx, output.DetectorName)
+	data.Enabled = types.BoolValue(output.AnomalyDetectorStatus != awstypes.AnomalyDetectorStatusPaused)
+	data.EvaluationFrequency = flex.StringValueToFramework(ctx, output.EvaluationFrequency)
+	data.FilterPattern = flex.StringToFramework(ctx, output.FilterPattern)
+	data.KMSKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
+	data.LogGroupARNList = flex.FlattenFrameworkStringValueSet(ctx, output.LogGroupArnList)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnomalyDetector) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new anomalyDetectorResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	if anomalyDetectorHasChanges(ctx, new, old) {
+		if err := updateAnomalyDetector(ctx, conn, &new); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Anomaly Detector (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findAnomalyDetectorByARN(ctx, conn, new.ARN.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Anomaly Detector (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.AnomalyVisibilityTime = flex.Int64ToFramework(ctx, output.AnomalyVisibilityTime)
+		new.EvaluationFrequency = flex.StringValueToFramework(ctx, output.EvaluationFrequency)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceAnomalyDetector) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data anomalyDetectorResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	_, err := conn.DeleteLogAnomalyDetector(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: flex.StringFromFramework(ctx, data.ARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceAnomalyDetector) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.Client, data *anomalyDetectorResourceModel) error {
+	input := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn:    flex.StringFromFramework(ctx, data.ARN),
+		AnomalyVisibilityTime: flex.Int64FromFramework(ctx, data.AnomalyVisibilityTime),
+		Enabled:               flex.BoolFromFramework(ctx, data.Enabled),
+		EvaluationFrequency:   awstypes.EvaluationFrequency(data.EvaluationFrequency.ValueString()),
+		FilterPattern:         flex.StringFromFramework(ctx, data.FilterPattern),
+	}
+
+	_, err := conn.UpdateLogAnomalyDetector(ctx, input)
+
+	return err
+}
+
+func findAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.Client, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetector(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.AnomalyDetectorStatus; status == awstypes.AnomalyDetectorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type anomalyDetectorResourceModel struct {
+	AnomalyVisibilityTime types.Int64  `tfsdk:"anomaly_visibility_time"`
+	ARN                   types.String `tfsdk:"arn"`
+	DetectorName          types.String `tfsdk:"detector_name"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	EvaluationFrequency   types.String `tfsdk:"evaluation_frequency"`
+	FilterPattern         types.String `tfsdk:"filter_pattern"`
+	ID                    types.String `tfsdk:"id"`
+	KMSKeyID              types.String `tfsdk:"kms_key_id"`
+	LogGroupARNList       types.Set    `tfsdk:"log_group_arn_list"`
+	Tags                  types.Map    `tfsdk:"tags"`
+	TagsAll               types.Map    `tfsdk:"tags_all"`
+}
+
+func (data *anomalyDetectorResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *anomalyDetectorResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+func anomalyDetectorHasChanges(_ context.Context, plan, state anomalyDetectorResourceModel) bool {
+	return !plan.AnomalyVisibilityTime.Equal(state.AnomalyVisibilityTime) ||
+		!plan.Enabled.Equal(state.Enabled) ||
+		!plan.EvaluationFrequency.Equal(state.EvaluationFrequency) ||
+		!plan.FilterPattern.Equal(state.FilterPattern)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// CloudWatch Logs retains log events for at most 10 years (3653 days), so no query can usefully span more.
+	insightsQueryMaxTimeRange = 3653 * 24 * time.Hour
+
+	// Maximum time to wait for a query that timed out to be stopped.
+	insightsQueryStopTimeout = 1 * time.Minute
+)
+
+// @SDKDataSource("aws_cloudwatch_log_insights_query")
+func dataSourceInsightsQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInsightsQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"log_group_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validLogGroupName,
+				},
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_matched": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInsightsQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+
+	if err != nil {
+		return diag.Errorf("parsing start_time: %s", err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+
+	if err != nil {
+		return diag.Errorf("parsing end_time: %s", err)
+	}
+
+	if !endTime.After(startTime) {
+		return diag.Errorf("end_time (%s) must be after start_time (%s)", d.Get("end_time").(string), d.Get("start_time").(string))
+	}
+
+	if endTime.Sub(startTime) > insightsQueryMaxTimeRange {
+		return diag.Errorf("time range between start_time (%s) and end_time (%s) must not exceed %s", d.Get("start_time").(string), d.Get("end_time").(string), insightsQueryMaxTimeRange)
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:       aws.Int64(endTime.Unix()),
+		LogGroupNames: flex.ExpandStringValueSet(d.Get("log_group_names").(*schema.Set)),
+		QueryString:   aws.String(d.Get("query_string").(string)),
+		StartTime:     aws.Int64(startTime.Unix()),
+	}
+
+	if v, ok := d.GetOk("limit"); ok {
+		input.Limit = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.StartQuery(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("starting CloudWatch Logs Insights query: %s", err)
+	}
+
+	queryID := aws.ToString(output.QueryId)
+	results, err := waitQueryCompleted(ctx, conn, queryID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		// Don't leave the query consuming concurrency after giving up on it.
+		// The Read context may itself have expired, so stop the query using a fresh one.
+		stopCtx, cancel := context.WithTimeout(context.Background(), insightsQueryStopTimeout)
+		defer cancel()
+
+		if _, err := conn.StopQuery(stopCtx, &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)}); err != nil {
+			log.Printf("[WARN] stopping CloudWatch Logs Insights query (%s): %s", queryID, err)
+		}
+
+		return diag.Errorf("waiting for CloudWatch Logs Insights query (%s) to complete: %s", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("query_id", queryID)
+	if err := d.Set("results", flattenQueryResults(results.Results)); err != nil {
+		return diag.Errorf("setting results: %s", err)
+	}
+	if err := d.Set("statistics", flattenQueryStatistics(results.Statistics)); err != nil {
+		return diag.Errorf("setting statistics: %s", err)
+	}
+	d.Set("status", results.Status)
+
+	return nil
+}
+
+func findQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueryCompleted(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.QueryStatusScheduled, types.QueryStatusRunning),
+		Target:     enum.Slice(types.QueryStatusComplete),
+		Refresh:    statusQuery(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenQueryResults(apiObjects [][]types.ResultField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, row := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		for _, field := range row {
+			tfMap[aws.ToString(field.Field)] = aws.ToString(field.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQueryStatistics(apiObject *types.QueryStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bytes_scanned":   apiObject.BytesScanned,
+		"records_matched": apiObject.RecordsMatched,
+		"records_scanned": apiObject.RecordsScanned,
+	}
+
+	return []interface{}{tfMap}
+}
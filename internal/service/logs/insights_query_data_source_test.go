@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsInsightsQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Complete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsInsightsQueryDataSource_invalidTimeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInsightsQueryDataSourceConfig_timeRange(rName, "2023-11-02T00:00:00Z", "2023-11-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`end_time .* must be after start_time`),
+			},
+			{
+				Config:      testAccInsightsQueryDataSourceConfig_timeRange(rName, "2010-01-01T00:00:00Z", "2023-11-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`time range between start_time .* and end_time .* must not exceed`),
+			},
+		},
+	})
+}
+
+func testAccInsightsQueryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  query_string    = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+  start_time      = timeadd(timestamp(), "-1h")
+  end_time        = timestamp()
+}
+`, rName)
+}
+
+func testAccInsightsQueryDataSourceConfig_timeRange(rName, startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [%[1]q]
+  query_string    = "fields @timestamp"
+  start_time      = %[2]q
+  end_time        = %[3]q
+}
+`, rName, startTime, endTime)
+}
@@ -0,0 +1,566 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Deployment")
+func newResourceDeployment(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDeployment{}
+
+	r.SetDefaultCreateTimeout(1 * time.Hour)
+	r.SetDefaultUpdateTimeout(1 * time.Hour)
+	r.SetDefaultDeleteTimeout(1 * time.Hour)
+
+	return r, nil
+}
+
+type resourceDeployment struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceDeployment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_deployment"
+}
+
+func (r *resourceDeployment) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_version": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_stop": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"start": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDeployment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data deploymentResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	deploymentID, err := createDeployment(ctx, conn, applicationID, data.EnvironmentID.ValueString(), int32(data.ApplicationVersion.ValueInt64()), timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating M2 Deployment (%s)", applicationID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DeploymentID = types.StringValue(deploymentID)
+	if err := data.setID(); err != nil {
+		response.Diagnostics.AddError("flattening resource ID", err.Error())
+
+		return
+	}
+
+	if data.Start.ValueBool() {
+		if err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("starting M2 Application (%s)", applicationID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDeployment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data deploymentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findDeploymentByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.DeploymentID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading M2 Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	application, err := findApplicationByID(ctx, conn, data.ApplicationID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading M2 Application (%s)", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Start = types.BoolValue(application.Status == awstypes.ApplicationLifecycleRunning)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDeployment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new deploymentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	applicationID := new.ApplicationID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.ApplicationVersion.Equal(old.ApplicationVersion) {
+		// A running application must be stopped before a new version can be deployed.
+		if err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping M2 Application (%s)", applicationID), err.Error())
+
+			return
+		}
+
+		deploymentID, err := createDeployment(ctx, conn, applicationID, new.EnvironmentID.ValueString(), int32(new.ApplicationVersion.ValueInt64()), timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating M2 Deployment (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DeploymentID = types.StringValue(deploymentID)
+		if err := new.setID(); err != nil {
+			response.Diagnostics.AddError("flattening resource ID", err.Error())
+
+			return
+		}
+
+		if new.Start.ValueBool() {
+			if err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting M2 Application (%s)", applicationID), err.Error())
+
+				return
+			}
+		}
+	} else if !new.Start.Equal(old.Start) {
+		if new.Start.ValueBool() {
+			if err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting M2 Application (%s)", applicationID), err.Error())
+
+				return
+			}
+		} else {
+			if err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("stopping M2 Application (%s)", applicationID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDeployment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data deploymentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	if err := stopApplicationIfRunning(ctx, conn, applicationID, data.ForceStop.ValueBool(), timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping M2 Application (%s)", applicationID), err.Error())
+
+		return
+	}
+
+	_, err := conn.DeleteApplicationFromEnvironment(ctx, &m2.DeleteApplicationFromEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(data.EnvironmentID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting M2 Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitApplicationDeletedFromEnvironment(ctx, conn, applicationID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for M2 Deployment (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDeployment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+	// Set values for arguments that are not returned by the API.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("force_stop"), false)...)
+}
+
+func (r *resourceDeployment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var old, new deploymentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Deploying a new application version creates a new deployment.
+	if !new.ApplicationVersion.Equal(old.ApplicationVersion) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("deployment_id"), types.StringUnknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrID), types.StringUnknown())...)
+	}
+}
+
+func createDeployment(ctx context.Context, conn *m2.Client, applicationID, environmentID string, applicationVersion int32, timeout time.Duration) (string, error) {
+	output, err := conn.CreateDeployment(ctx, &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String(applicationID),
+		ApplicationVersion: aws.Int32(applicationVersion),
+		EnvironmentId:      aws.String(environmentID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	deploymentID := aws.ToString(output.DeploymentId)
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, applicationID, deploymentID, timeout); err != nil {
+		return "", fmt.Errorf("waiting for deployment (%s): %w", deploymentID, err)
+	}
+
+	return deploymentID, nil
+}
+
+func startApplication(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) error {
+	_, err := conn.StartApplication(ctx, &m2.StartApplicationInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitApplicationRunning(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for start: %w", err)
+	}
+
+	return nil
+}
+
+func stopApplicationIfRunning(ctx context.Context, conn *m2.Client, id string, forceStop bool, timeout time.Duration) error {
+	application, err := findApplicationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if application.Status != awstypes.ApplicationLifecycleRunning {
+		return nil
+	}
+
+	_, err = conn.StopApplication(ctx, &m2.StopApplicationInput{
+		ApplicationId: aws.String(id),
+		ForceStop:     forceStop,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitApplicationStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for stop: %w", err)
+	}
+
+	return nil
+}
+
+func findDeploymentByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, deploymentID string) (*m2.GetDeploymentOutput, error) {
+	input := &m2.GetDeploymentInput{
+		ApplicationId: aws.String(applicationID),
+		DeploymentId:  aws.String(deploymentID),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *m2.Client, applicationID, deploymentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentSucceeded(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeploymentLifecycleDeploying, awstypes.DeploymentLifecycleDeployUpdate),
+		Target:  enum.Slice(awstypes.DeploymentLifecycleSucceeded),
+		Refresh: statusDeployment(ctx, conn, applicationID, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetDeploymentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationRunning(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationLifecycleStarting),
+		Target:  enum.Slice(awstypes.ApplicationLifecycleRunning),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationStopped(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationLifecycleStopping),
+		Target:  enum.Slice(awstypes.ApplicationLifecycleStopped),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeletedFromEnvironment(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationLifecycleDeletingFromEnvironment),
+		Target:  enum.Slice(awstypes.ApplicationLifecycleAvailable, awstypes.ApplicationLifecycleCreated),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type deploymentResourceModel struct {
+	ApplicationID      types.String   `tfsdk:"application_id"`
+	ApplicationVersion types.Int64    `tfsdk:"application_version"`
+	DeploymentID       types.String   `tfsdk:"deployment_id"`
+	EnvironmentID      types.String   `tfsdk:"environment_id"`
+	ForceStop          types.Bool     `tfsdk:"force_stop"`
+	ID                 types.String   `tfsdk:"id"`
+	Start              types.Bool     `tfsdk:"start"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+const (
+	deploymentResourceIDPartCount = 2
+)
+
+func (data *deploymentResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), deploymentResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.DeploymentID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *deploymentResourceModel) setID() error {
+	parts := []string{
+		data.ApplicationID.ValueString(),
+		data.DeploymentID.ValueString(),
+	}
+
+	id, err := flex.FlattenResourceId(parts, deploymentResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(id)
+
+	return nil
+}
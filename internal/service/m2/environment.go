@@ -0,0 +1,648 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment")
+// @Tags(identifierAttribute="arn")
+func newResourceEnvironment(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnvironment{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultUpdateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type resourceEnvironment struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceEnvironment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_environment"
+}
+
+func (r *resourceEnvironment) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"apply_changes_during_maintenance_window": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"description": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 500),
+				},
+			},
+			"engine_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.EngineType](),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_update": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_type": schema.StringAttribute{
+				Required: true,
+			},
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"load_balancer_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 letters, numbers, hyphens or underscores, starting with a letter or number"),
+				},
+			},
+			"preferred_maintenance_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"publicly_accessible": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"high_availability_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[highAvailabilityConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"desired_capacity": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 100),
+							},
+						},
+					},
+				},
+			},
+			"storage_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[storageConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"efs": fileSystemStorageConfigurationBlock(ctx),
+						"fsx": fileSystemStorageConfigurationBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func fileSystemStorageConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[fileSystemStorageConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"file_system_id": schema.StringAttribute{
+					Required: true,
+				},
+				"mount_point": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEnvironment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	input := &m2.CreateEnvironmentInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEnvironment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating M2 Environment (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.EnvironmentID = flex.StringToFramework(ctx, output.EnvironmentId)
+	data.setID()
+
+	environment, err := waitEnvironmentCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for M2 Environment (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, environment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnvironment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findEnvironmentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading M2 Environment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnvironment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new environmentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	if environmentHasChanges(ctx, new, old) {
+		input := &m2.UpdateEnvironmentInput{
+			ApplyDuringMaintenanceWindow: new.ApplyChangesDuringMaintenanceWindow.ValueBool(),
+			EnvironmentId:                flex.StringFromFramework(ctx, new.ID),
+			ForceUpdate:                  new.ForceUpdate.ValueBool(),
+		}
+
+		if !new.EngineVersion.Equal(old.EngineVersion) {
+			input.EngineVersion = flex.StringFromFramework(ctx, new.EngineVersion)
+		}
+
+		if !new.HighAvailabilityConfig.Equal(old.HighAvailabilityConfig) {
+			highAvailabilityConfig, diags := new.HighAvailabilityConfig.ToPtr(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if highAvailabilityConfig != nil {
+				input.DesiredCapacity = flex.Int32FromFramework(ctx, highAvailabilityConfig.DesiredCapacity)
+			}
+		}
+
+		if !new.InstanceType.Equal(old.InstanceType) {
+			input.InstanceType = flex.StringFromFramework(ctx, new.InstanceType)
+		}
+
+		if !new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
+			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, new.PreferredMaintenanceWindow)
+		}
+
+		_, err := conn.UpdateEnvironment(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating M2 Environment (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		environment, err := waitEnvironmentUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for M2 Environment (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Changes applied in the maintenance window are not yet visible.
+		if !new.ApplyChangesDuringMaintenanceWindow.ValueBool() {
+			response.Diagnostics.Append(flex.Flatten(ctx, environment, &new)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceEnvironment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	_, err := conn.DeleteEnvironment(ctx, &m2.DeleteEnvironmentInput{
+		EnvironmentId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting M2 Environment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for M2 Environment (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceEnvironment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+	// Set values for arguments that are not returned by the API.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("apply_changes_during_maintenance_window"), false)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("force_update"), false)...)
+}
+
+func (r *resourceEnvironment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func environmentHasChanges(_ context.Context, plan, state environmentResourceModel) bool {
+	return !plan.EngineVersion.Equal(state.EngineVersion) ||
+		!plan.HighAvailabilityConfig.Equal(state.HighAvailabilityConfig) ||
+		!plan.InstanceType.Equal(state.InstanceType) ||
+		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow)
+}
+
+func findEnvironmentByID(ctx context.Context, conn *m2.Client, id string) (*m2.GetEnvironmentOutput, error) {
+	input := &m2.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+
+	output, err := conn.GetEnvironment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEnvironment(ctx context.Context, conn *m2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentLifecycleCreating),
+		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
+		Refresh: statusEnvironment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentLifecycleUpdating),
+		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
+		Refresh: statusEnvironment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentLifecycleAvailable, awstypes.EnvironmentLifecycleCreating, awstypes.EnvironmentLifecycleDeleting),
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type environmentResourceModel struct {
+	ApplyChangesDuringMaintenanceWindow types.Bool                                                   `tfsdk:"apply_changes_during_maintenance_window"`
+	Description                         types.String                                                 `tfsdk:"description"`
+	EngineType                          types.String                                                 `tfsdk:"engine_type"`
+	EngineVersion                       types.String                                                 `tfsdk:"engine_version"`
+	EnvironmentARN                      types.String                                                 `tfsdk:"arn"`
+	EnvironmentID                       types.String                                                 `tfsdk:"environment_id"`
+	ForceUpdate                         types.Bool                                                   `tfsdk:"force_update"`
+	HighAvailabilityConfig              fwtypes.ListNestedObjectValueOf[highAvailabilityConfigModel] `tfsdk:"high_availability_config"`
+	ID                                  types.String                                                 `tfsdk:"id"`
+	InstanceType                        types.String                                                 `tfsdk:"instance_type"`
+	KmsKeyID                            types.String                                                 `tfsdk:"kms_key_id"`
+	LoadBalancerARN                     types.String                                                 `tfsdk:"load_balancer_arn"`
+	Name                                types.String                                                 `tfsdk:"name"`
+	PreferredMaintenanceWindow          types.String                                                 `tfsdk:"preferred_maintenance_window"`
+	PubliclyAccessible                  types.Bool                                                   `tfsdk:"publicly_accessible"`
+	SecurityGroupIDs                    types.Set                                                    `tfsdk:"security_group_ids"`
+	StorageConfigurations               fwtypes.ListNestedObjectValueOf[storageConfigurationModel]   `tfsdk:"storage_configuration"`
+	SubnetIDs                           types.Set                                                    `tfsdk:"subnet_ids"`
+	Tags                                types.Map                                                    `tfsdk:"tags"`
+	TagsAll                             types.Map                                                    `tfsdk:"tags_all"`
+	Timeouts                            timeouts.Value                                               `tfsdk:"timeouts"`
+}
+
+func (data *environmentResourceModel) InitFromID() {
+	data.EnvironmentID = data.ID
+}
+
+func (data *environmentResourceModel) setID() {
+	data.ID = data.EnvironmentID
+}
+
+type highAvailabilityConfigModel struct {
+	DesiredCapacity types.Int64 `tfsdk:"desired_capacity"`
+}
+
+// storageConfigurationModel models the awstypes.StorageConfiguration union.
+type storageConfigurationModel struct {
+	EFS fwtypes.ListNestedObjectValueOf[fileSystemStorageConfigurationModel] `tfsdk:"efs"`
+	FSx fwtypes.ListNestedObjectValueOf[fileSystemStorageConfigurationModel] `tfsdk:"fsx"`
+}
+
+var (
+	_ flex.Expander  = storageConfigurationModel{}
+	_ flex.Flattener = &storageConfigurationModel{}
+)
+
+func (m storageConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	efsData, d := m.EFS.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if efsData != nil {
+		var r awstypes.StorageConfigurationMemberEfs
+		diags.Append(flex.Expand(ctx, efsData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	fsxData, d := m.FSx.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if fsxData != nil {
+		var r awstypes.StorageConfigurationMemberFsx
+		diags.Append(flex.Expand(ctx, fsxData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *storageConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.StorageConfigurationMemberEfs:
+		var efsData fileSystemStorageConfigurationModel
+		diags.Append(flex.Flatten(ctx, t.Value, &efsData)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.EFS = fwtypes.NewListNestedObjectValueOfPtr(ctx, &efsData)
+		m.FSx = fwtypes.NewListNestedObjectValueOfNull[fileSystemStorageConfigurationModel](ctx)
+
+	case *awstypes.StorageConfigurationMemberFsx:
+		var fsxData fileSystemStorageConfigurationModel
+		diags.Append(flex.Flatten(ctx, t.Value, &fsxData)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.EFS = fwtypes.NewListNestedObjectValueOfNull[fileSystemStorageConfigurationModel](ctx)
+		m.FSx = fwtypes.NewListNestedObjectValueOfPtr(ctx, &fsxData)
+	}
+
+	return diags
+}
+
+type fileSystemStorageConfigurationModel struct {
+	FileSystemID types.String `tfsdk:"file_system_id"`
+	MountPoint   types.String `tfsdk:"mount_point"`
+}
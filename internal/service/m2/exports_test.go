@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+// Exports for use in tests only.
+var (
+	ResourceApplication = newResourceApplication
+	ResourceDeployment  = newResourceDeployment
+	ResourceEnvironment = newResourceEnvironment
+
+	FindApplicationByID        = findApplicationByID
+	FindDeploymentByTwoPartKey = findDeploymentByTwoPartKey
+	FindEnvironmentByID        = findEnvironmentByID
+)
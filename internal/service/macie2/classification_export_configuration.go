@@ -129,7 +129,7 @@ func resourceClassificationExportConfigurationRead(ctx context.Context, d *schem
 				return diag.Errorf("setting Macie classification export configuration s3_destination: %s", err)
 			}
 		}
-		d.SetId(fmt.Sprintf("%s:%s:%s", "macie:classification_export_configuration", meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region))
+		d.SetId(fmt.Sprintf("%s:%s:%s", "macie:classification_export_configuration", meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).ResourceRegion(d)))
 	}
 
 	return nil
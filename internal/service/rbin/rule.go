@@ -211,7 +211,7 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	ruleArn := awsarn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   rbin.ServiceID,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("rule/%s", aws.ToString(out.Identifier)),
 	}.String()
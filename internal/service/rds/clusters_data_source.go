@@ -73,7 +73,7 @@ func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta in
 		return create.DiagError(names.RDS, create.ErrActionReading, DSNameClusters, "", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("cluster_arns", clusterArns)
 	d.Set("cluster_identifiers", clusterIdentifiers)
 
@@ -170,7 +170,7 @@ func resourceInstanceAutomatedBackupsReplicationDelete(ctx context.Context, d *s
 
 	// Create a new client to the source region.
 	sourceDatabaseConn := conn
-	if sourceDatabaseARN.Region != meta.(*conns.AWSClient).Region {
+	if sourceDatabaseARN.Region != meta.(*conns.AWSClient).ResourceRegion(d) {
 		sourceDatabaseConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(sourceDatabaseARN.Region))
 	}
 
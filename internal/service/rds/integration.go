@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_integration", name="Integration")
+// @Tags(identifierAttribute="arn")
+func ResourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationCreate,
+		ReadWithoutTimeout:   resourceIntegrationRead,
+		UpdateWithoutTimeout: resourceIntegrationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	name := d.Get("integration_name").(string)
+	input := &rds.CreateIntegrationInput{
+		IntegrationName: aws.String(name),
+		SourceArn:       aws.String(d.Get("source_arn").(string)),
+		Tags:            getTagsIn(ctx),
+		TargetArn:       aws.String(d.Get("target_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIntegrationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Integration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IntegrationArn))
+
+	if _, err := waitIntegrationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Integration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIntegrationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	integration, err := FindIntegrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Integration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(integration.AdditionalEncryptionContext))
+	d.Set("arn", integration.IntegrationArn)
+	d.Set("integration_name", integration.IntegrationName)
+	d.Set("kms_key_id", integration.KMSKeyId)
+	d.Set("source_arn", integration.SourceArn)
+	d.Set("target_arn", integration.TargetArn)
+
+	setTagsOut(ctx, integration.Tags)
+
+	return diags
+}
+
+func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting RDS Integration: %s", d.Id())
+	_, err := conn.DeleteIntegrationWithContext(ctx, &rds.DeleteIntegrationInput{
+		IntegrationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Integration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Integration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIntegrationByARN(ctx context.Context, conn *rds.RDS, arn string) (*rds.Integration, error) {
+	input := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+	output, err := findIntegration(ctx, conn, input, tfslices.PredicateTrue[*rds.Integration]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.IntegrationArn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findIntegration(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput, filter tfslices.Predicate[*rds.Integration]) (*rds.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findIntegrations(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput, filter tfslices.Predicate[*rds.Integration]) ([]*rds.Integration, error) {
+	var output []*rds.Integration
+
+	err := conn.DescribeIntegrationsPagesWithContext(ctx, input, func(page *rds.DescribeIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Integrations {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusIntegration(ctx context.Context, conn *rds.RDS, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rds.IntegrationStatusCreating, rds.IntegrationStatusModifying},
+		Target:  []string{rds.IntegrationStatusActive},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rds.IntegrationStatusDeleting, rds.IntegrationStatusActive},
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func integrationError(apiObjects []*rds.IntegrationError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
@@ -197,6 +197,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ImportByARN: &types.ServicePackageResourceImportByARN{
+				Service:  "rds",
+				Resource: "cluster:{cluster_identifier}",
+			},
 		},
 		{
 			Factory:  ResourceClusterActivityStream,
@@ -242,6 +246,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
 		},
+		{
+			Factory:  ResourceIntegration,
+			TypeName: "aws_rds_integration",
+			Name:     "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceReservedInstance,
 			TypeName: "aws_rds_reserved_instance",
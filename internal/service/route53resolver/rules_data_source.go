@@ -93,7 +93,7 @@ func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("listing Route53 Resolver Rules: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 
 	d.Set("resolver_rule_ids", aws.StringValueSlice(ruleIDs))
 
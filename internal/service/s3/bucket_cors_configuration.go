@@ -252,7 +252,7 @@ func expandCORSRules(l []interface{}) []types.CORSRule {
 		}
 
 		if v, ok := tfMap["max_age_seconds"].(int); ok {
-			rule.MaxAgeSeconds = int32(v)
+			rule.MaxAgeSeconds = aws.Int32(int32(v))
 		}
 
 		rules = append(rules, rule)
@@ -266,7 +266,7 @@ func flattenCORSRules(rules []types.CORSRule) []interface{} {
 
 	for _, rule := range rules {
 		m := map[string]interface{}{
-			"max_age_seconds": rule.MaxAgeSeconds,
+			"max_age_seconds": aws.ToInt32(rule.MaxAgeSeconds),
 		}
 
 		if len(rule.AllowedHeaders) > 0 {
@@ -316,7 +316,7 @@ func expandTiering(tfMap map[string]interface{}) *types.Tiering {
 	}
 
 	if v, ok := tfMap["days"].(int); ok && v != 0 {
-		apiObject.Days = int32(v)
+		apiObject.Days = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -381,7 +381,7 @@ func flattenIntelligentTieringFilter(ctx context.Context, apiObject *types.Intel
 func flattenTiering(apiObject types.Tiering) map[string]interface{} {
 	tfMap := map[string]interface{}{
 		"access_tier": apiObject.AccessTier,
-		"days":        apiObject.Days,
+		"days":        aws.ToInt32(apiObject.Days),
 	}
 
 	return tfMap
@@ -314,7 +314,7 @@ func resourceBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, met
 			o, n := expandObjectDate(oraw.(string)), expandObjectDate(nraw.(string))
 
 			if n == nil || (o != nil && n.Before(*o)) {
-				input.BypassGovernanceRetention = true
+				input.BypassGovernanceRetention = aws.Bool(true)
 			}
 		}
 
@@ -430,7 +430,7 @@ func resourceBucketObjectUpload(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if v, ok := d.GetOk("bucket_key_enabled"); ok {
-		input.BucketKeyEnabled = v.(bool)
+		input.BucketKeyEnabled = aws.Bool(v.(bool))
 	}
 
 	if v, ok := d.GetOk("cache_control"); ok {
@@ -271,7 +271,7 @@ func expandBucketServerSideEncryptionConfigurationRules(l []interface{}) []types
 		}
 
 		if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
-			rule.BucketKeyEnabled = v
+			rule.BucketKeyEnabled = aws.Bool(v)
 		}
 		rules = append(rules, rule)
 	}
@@ -284,7 +284,7 @@ func flattenBucketServerSideEncryptionConfigurationRules(rules []types.ServerSid
 
 	for _, rule := range rules {
 		m := map[string]interface{}{
-			"bucket_key_enabled": rule.BucketKeyEnabled,
+			"bucket_key_enabled": aws.ToBool(rule.BucketKeyEnabled),
 		}
 
 		if rule.ApplyServerSideEncryptionByDefault != nil {
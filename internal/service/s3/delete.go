@@ -34,6 +34,88 @@ func emptyBucket(ctx context.Context, conn *s3.Client, bucket string, force bool
 	return nObjects, err
 }
 
+// emptyDirectoryBucket empties the specified S3 directory bucket by deleting all objects.
+// Directory buckets do not support versioning or S3 Object Lock.
+// Returns the number of objects deleted.
+func emptyDirectoryBucket(ctx context.Context, conn *s3.Client, bucket string) (int64, error) {
+	var nObjects int64
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("listing S3 directory bucket (%s) objects: %w", bucket, err)
+		}
+
+		n, err := deletePageOfObjects(ctx, conn, bucket, page)
+		nObjects += n
+
+		if err != nil {
+			return nObjects, err
+		}
+	}
+
+	return nObjects, nil
+}
+
+// deletePageOfObjects deletes a page (<= 1000) of S3 objects.
+// Returns the number of objects deleted.
+func deletePageOfObjects(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectsV2Output) (int64, error) {
+	var nObjects int64
+
+	toDelete := make([]types.ObjectIdentifier, 0, len(page.Contents))
+	for _, v := range page.Contents {
+		toDelete = append(toDelete, types.ObjectIdentifier{
+			Key: v.Key,
+		})
+	}
+
+	if nObjects = int64(len(toDelete)); nObjects == 0 {
+		return nObjects, nil
+	}
+
+	input := &s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &types.Delete{
+			Objects: toDelete,
+			Quiet:   aws.Bool(true), // Only report errors.
+		},
+	}
+
+	output, err := conn.DeleteObjects(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return nObjects, nil
+	}
+
+	if err != nil {
+		return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+	}
+
+	nObjects -= int64(len(output.Errors))
+
+	var deleteErrs []error
+
+	for _, v := range output.Errors {
+		if aws.ToString(v.Code) == errCodeNoSuchKey {
+			continue
+		}
+
+		deleteErrs = append(deleteErrs, newDeleteObjectVersionError(v))
+	}
+
+	if err := errors.Join(deleteErrs...); err != nil {
+		return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nObjects, nil
+}
+
 // forEachObjectVersionsPage calls the specified function for each page returned from the S3 ListObjectVersionsPages API.
 func forEachObjectVersionsPage(ctx context.Context, conn *s3.Client, bucket string, fn func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
 	var nObjects int64
@@ -88,10 +170,10 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket str
 
 	input := &s3.DeleteObjectsInput{
 		Bucket:                    aws.String(bucket),
-		BypassGovernanceRetention: force,
+		BypassGovernanceRetention: aws.Bool(force),
 		Delete: &types.Delete{
 			Objects: toDelete,
-			Quiet:   true, // Only report errors.
+			Quiet:   aws.Bool(true), // Only report errors.
 		},
 	}
 
@@ -181,7 +263,7 @@ func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.Client, bucket stri
 		Bucket: aws.String(bucket),
 		Delete: &types.Delete{
 			Objects: toDelete,
-			Quiet:   true, // Only report errors.
+			Quiet:   aws.Bool(true), // Only report errors.
 		},
 	}
 
@@ -386,7 +468,7 @@ func deleteObjectVersion(ctx context.Context, conn *s3.Client, b, k, v string, f
 		input.VersionId = aws.String(v)
 	}
 	if force {
-		input.BypassGovernanceRetention = true
+		input.BypassGovernanceRetention = aws.Bool(true)
 	}
 
 	log.Printf("[INFO] Deleting S3 Bucket (%s) Object (%s) Version (%s)", b, k, v)
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// e.g. example--usw2-az2--x-s3
+	directoryBucketNameRegex = regexache.MustCompile(`^([0-9a-z.-]+)--([a-z]+\d+-az\d+)--x-s3$`)
+)
+
+// isDirectoryBucket returns whether or not the specified bucket name is that of an S3 directory bucket.
+func isDirectoryBucket(bucket string) bool {
+	return directoryBucketNameRegex.MatchString(bucket)
+}
+
+// @FrameworkResource(name="Directory Bucket")
+func newResourceDirectoryBucket(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDirectoryBucket{}
+
+	return r, nil
+}
+
+type resourceDirectoryBucket struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceDirectoryBucket) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_bucket"
+}
+
+func (r *resourceDirectoryBucket) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	dataRedundancyType := fwtypes.StringEnumType[awstypes.DataRedundancy]()
+	bucketTypeType := fwtypes.StringEnumType[awstypes.BucketType]()
+	locationTypeType := fwtypes.StringEnumType[awstypes.LocationType]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3. Use the aws_s3_bucket resource to manage general purpose buckets`),
+				},
+			},
+			"data_redundancy": schema.StringAttribute{
+				CustomType: dataRedundancyType,
+				Optional:   true,
+				Computed:   true,
+				Default:    dataRedundancyType.AttributeDefault(awstypes.DataRedundancySingleAvailabilityZone),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"type": schema.StringAttribute{
+				CustomType: bucketTypeType,
+				Optional:   true,
+				Computed:   true,
+				Default:    bucketTypeType.AttributeDefault(awstypes.BucketTypeDirectory),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[locationInfoModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							CustomType: locationTypeType,
+							Optional:   true,
+							Computed:   true,
+							Default:    locationTypeType.AttributeDefault(awstypes.LocationTypeAvailabilityZone),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDirectoryBucket) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directoryBucketResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	locationInfoData, diags := data.Location.ToPtr(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	input := &s3.CreateBucketInput{
+		Bucket: flex.StringFromFramework(ctx, data.Bucket),
+		CreateBucketConfiguration: &awstypes.CreateBucketConfiguration{
+			Bucket: &awstypes.BucketInfo{
+				DataRedundancy: data.DataRedundancy.ValueEnum(),
+				Type:           data.Type.ValueEnum(),
+			},
+			Location: &awstypes.LocationInfo{
+				Name: flex.StringFromFramework(ctx, locationInfoData.Name),
+				Type: locationInfoData.Type.ValueEnum(),
+			},
+		},
+	}
+
+	_, err := conn.CreateBucket(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Bucket (%s)", data.Bucket.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = types.StringValue(r.arn(data.Bucket.ValueString()))
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directoryBucketResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	err := findBucket(ctx, conn, data.Bucket.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// No API to return bucket type, data redundancy or location, so derive them from the bucket name.
+	data.ARN = types.StringValue(r.arn(data.Bucket.ValueString()))
+	data.DataRedundancy = fwtypes.StringEnumValue(awstypes.DataRedundancySingleAvailabilityZone)
+	if matches := directoryBucketNameRegex.FindStringSubmatch(data.Bucket.ValueString()); len(matches) == 3 {
+		data.Location = fwtypes.NewListNestedObjectValueOfPtr(ctx, &locationInfoModel{
+			Name: flex.StringValueToFramework(ctx, matches[2]),
+			Type: fwtypes.StringEnumValue(awstypes.LocationTypeAvailabilityZone),
+		})
+	}
+	data.Type = fwtypes.StringEnumValue(awstypes.BucketTypeDirectory)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new directoryBucketResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only force_destroy can be updated in-place and it is a Terraform-only argument.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDirectoryBucket) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directoryBucketResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	tflog.Debug(ctx, "deleting S3 Directory Bucket", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{
+		Bucket: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeBucketNotEmpty) && data.ForceDestroy.ValueBool() {
+		// Empty the bucket and try again.
+		n, err := emptyDirectoryBucket(ctx, conn, data.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("emptying S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		tflog.Debug(ctx, "deleted S3 objects", map[string]interface{}{
+			"count": n,
+		})
+
+		_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{
+			Bucket: flex.StringFromFramework(ctx, data.ID),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDirectoryBucket) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.WithImportByID.ImportState(ctx, request, response)
+
+	// Set force_destroy to false on import to prevent an immediate diff.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+}
+
+// arn returns the ARN of the specified bucket.
+func (r *resourceDirectoryBucket) arn(bucket string) string {
+	return r.RegionalARN("s3express", fmt.Sprintf("bucket/%s", bucket))
+}
+
+type directoryBucketResourceModel struct {
+	ARN            types.String                                       `tfsdk:"arn"`
+	Bucket         types.String                                       `tfsdk:"bucket"`
+	DataRedundancy fwtypes.StringEnum[awstypes.DataRedundancy]        `tfsdk:"data_redundancy"`
+	ForceDestroy   types.Bool                                         `tfsdk:"force_destroy"`
+	Location       fwtypes.ListNestedObjectValueOf[locationInfoModel] `tfsdk:"location"`
+	ID             types.String                                       `tfsdk:"id"`
+	Type           fwtypes.StringEnum[awstypes.BucketType]            `tfsdk:"type"`
+}
+
+func (data *directoryBucketResourceModel) InitFromID() error {
+	data.Bucket = data.ID
+
+	return nil
+}
+
+func (data *directoryBucketResourceModel) setID() {
+	data.ID = data.Bucket
+}
+
+type locationInfoModel struct {
+	Name types.String                              `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.LocationType] `tfsdk:"type"`
+}
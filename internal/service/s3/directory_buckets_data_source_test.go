@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3DirectoryBucketsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_directory_buckets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryBucketsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "arns.#", 0),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_s3_directory_bucket.test", "arn"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "buckets.#", 0),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "buckets.*", "aws_s3_directory_bucket.test", "bucket"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectoryBucketsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_basic(rName), `
+data "aws_s3_directory_buckets" "test" {
+  depends_on = [aws_s3_directory_bucket.test]
+}
+`)
+}
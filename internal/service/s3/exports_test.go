@@ -5,6 +5,8 @@ package s3
 
 // Exports for use in tests only.
 var (
+	ResourceDirectoryBucket = newResourceDirectoryBucket
+
 	DeleteAllObjectVersions               = deleteAllObjectVersions
 	EmptyBucket                           = emptyBucket
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
@@ -25,6 +27,7 @@ var (
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
+	IsDirectoryBucket                     = isDirectoryBucket
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
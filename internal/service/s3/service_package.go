@@ -17,6 +17,7 @@ import (
 	tfawserr_sdkv1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // NewConn returns a new AWS SDK for Go v1 client for this service package's AWS API.
@@ -55,7 +56,7 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 		} else if o.Region == endpoints_sdkv1.UsEast1RegionID && config["s3_us_east_1_regional_endpoint"].(endpoints_sdkv1.S3UsEast1RegionalEndpoint) != endpoints_sdkv1.RegionalS3UsEast1Endpoint {
 			// Maintain the AWS SDK for Go v1 default of using the global endpoint in us-east-1.
 			// See https://github.com/hashicorp/terraform-provider-aws/issues/33028.
-			o.Region = "aws-global"
+			o.Region = names.GlobalRegionID
 		}
 		o.UsePathStyle = config["s3_use_path_style"].(bool)
 
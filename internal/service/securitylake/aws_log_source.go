@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newResourceAWSLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAWSLogSource{}, nil
+}
+
+type resourceAWSLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAWSLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *resourceAWSLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsLogSourceSourceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"source_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+							Required:   true,
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAWSLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateAwsLogSourceInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake AWS Log Source", err.Error())
+
+		return
+	}
+
+	source, diags := data.Source.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(string(source.SourceName.ValueEnum()))
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	source.Accounts = flex.FlattenFrameworkStringValueSet(ctx, logSource.Accounts)
+	source.SourceVersion = flex.StringToFramework(ctx, logSource.SourceVersion)
+	data.Source = fwtypes.NewListNestedObjectValueOfPtr(ctx, source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var source awsLogSourceSourceModel
+	response.Diagnostics.Append(flex.Flatten(ctx, logSource, &source)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Source = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceAWSLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteAwsLogSourceInput{}
+	response.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.DeleteAwsLogSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type awsLogSourceResourceModel struct {
+	ID     types.String                                             `tfsdk:"id"`
+	Source fwtypes.ListNestedObjectValueOf[awsLogSourceSourceModel] `tfsdk:"source"`
+}
+
+type awsLogSourceSourceModel struct {
+	Accounts      types.Set                                     `tfsdk:"accounts"`
+	Regions       types.Set                                     `tfsdk:"regions"`
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions in which the named AWS log source is enabled.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: awstypes.AwsLogSourceName(sourceName),
+				},
+			},
+		},
+	}
+
+	output, err := findLogSources(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts, regions []string
+	var sourceVersion *string
+	seenAccounts, seenRegions := make(map[string]bool), make(map[string]bool)
+
+	for _, logSource := range output {
+		for _, v := range logSource.Sources {
+			v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+			if !ok || string(v.Value.SourceName) != sourceName {
+				continue
+			}
+
+			if account := aws.ToString(logSource.Account); account != "" && !seenAccounts[account] {
+				seenAccounts[account] = true
+				accounts = append(accounts, account)
+			}
+
+			if region := aws.ToString(logSource.Region); region != "" && !seenRegions[region] {
+				seenRegions[region] = true
+				regions = append(regions, region)
+			}
+
+			sourceVersion = v.Value.SourceVersion
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return &awstypes.AwsLogSourceConfiguration{
+		Accounts:      accounts,
+		Regions:       regions,
+		SourceName:    awstypes.AwsLogSourceName(sourceName),
+		SourceVersion: sourceVersion,
+	}, nil
+}
+
+func findLogSources(ctx context.Context, conn *securitylake.Client, input *securitylake.ListLogSourcesInput) ([]awstypes.LogSource, error) {
+	var output []awstypes.LogSource
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Sources...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+// Exports for use in tests only.
+var (
+	ResourceAWSLogSource    = newResourceAWSLogSource
+	ResourceCustomLogSource = newResourceCustomLogSource
+	ResourceDataLake        = newResourceDataLake
+	ResourceSubscriber      = newResourceSubscriber
+
+	FindAWSLogSourceBySourceName    = findAWSLogSourceBySourceName
+	FindCustomLogSourceBySourceName = findCustomLogSourceBySourceName
+	FindDataLakeByARN               = findDataLakeByARN
+	FindSubscriberByID              = findSubscriberByID
+)
@@ -0,0 +1,611 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newResourceSubscriber(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriber{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceSubscriber struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriber) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *resourceSubscriber) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN:         framework.ARNAttributeComputedOnly(),
+			names.AttrID:          framework.IDAttribute(),
+			"resource_share_arn":  computedString(),
+			"resource_share_name": computedString(),
+			"role_arn":            computedString(),
+			"s3_bucket_arn":       computedString(),
+			"subscriber_endpoint": computedString(),
+			"subscriber_status":   schema.StringAttribute{Computed: true},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberAWSLogSourceResourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("aws_log_source_resource"),
+									path.MatchRelative().AtParent().AtName("custom_log_source_resource"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"custom_log_source_resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberCustomLogSourceResourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										Required: true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberIdentityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriber) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	sources, diags := expandSubscriberSources(ctx, data.Sources)
+	response.Diagnostics.Append(diags...)
+	subscriberIdentity, diags := expandSubscriberIdentity(ctx, data.SubscriberIdentity)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberInput{
+		Sources:               sources,
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberIdentity:    subscriberIdentity,
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if !data.AccessType.IsUnknown() && !data.AccessType.IsNull() {
+		input.AccessTypes = []awstypes.AccessType{data.AccessType.ValueEnum()}
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		sources, diags := expandSubscriberSources(ctx, new.Sources)
+		response.Diagnostics.Append(diags...)
+		subscriberIdentity, diags := expandSubscriberIdentity(ctx, new.SubscriberIdentity)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               sources,
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberIdentity:    subscriberIdentity,
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, subscriber)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.SubscriberStatus = old.SubscriberStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriber) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSubscriber) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type subscriberResourceModel struct {
+	AccessType            fwtypes.StringEnum[awstypes.AccessType]                  `tfsdk:"access_type"`
+	ID                    types.String                                             `tfsdk:"id"`
+	ResourceShareARN      types.String                                             `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                             `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                             `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                             `tfsdk:"s3_bucket_arn"`
+	Sources               fwtypes.ListNestedObjectValueOf[subscriberSourceModel]   `tfsdk:"source"`
+	SubscriberARN         types.String                                             `tfsdk:"arn"`
+	SubscriberDescription types.String                                             `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                             `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[subscriberIdentityModel] `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                             `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                             `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                                `tfsdk:"tags"`
+	TagsAll               types.Map                                                `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *subscriberResourceModel) refreshFromOutput(ctx context.Context, apiObject *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Sources and access types need special handling.
+	diags.Append(flex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(apiObject.AccessTypes) > 0 {
+		data.AccessType = fwtypes.StringEnumValue(apiObject.AccessTypes[0])
+	} else {
+		data.AccessType = fwtypes.StringEnumNull[awstypes.AccessType]()
+	}
+
+	sources, d := flattenSubscriberSources(ctx, apiObject.Sources)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Sources = sources
+
+	return diags
+}
+
+type subscriberSourceModel struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[subscriberAWSLogSourceResourceModel]    `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[subscriberCustomLogSourceResourceModel] `tfsdk:"custom_log_source_resource"`
+}
+
+type subscriberAWSLogSourceResourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
+
+type subscriberCustomLogSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+type subscriberIdentityModel struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+func expandSubscriberSources(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberSourceModel]) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.LogSourceResource, 0, len(sources))
+
+	for _, source := range sources {
+		if v, d := source.AWSLogSourceResource.ToPtr(ctx); v != nil {
+			diags.Append(d...)
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    v.SourceName.ValueEnum(),
+					SourceVersion: flex.StringFromFramework(ctx, v.SourceVersion),
+				},
+			})
+		} else if v, d := source.CustomLogSourceResource.ToPtr(ctx); v != nil {
+			diags.Append(d...)
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    flex.StringFromFramework(ctx, v.SourceName),
+					SourceVersion: flex.StringFromFramework(ctx, v.SourceVersion),
+				},
+			})
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func expandSubscriberIdentity(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberIdentityModel]) (*awstypes.AwsIdentity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.AwsIdentity{}
+	diags.Append(flex.Expand(ctx, tfObject, apiObject)...)
+
+	return apiObject, diags
+}
+
+func flattenSubscriberSources(ctx context.Context, apiObjects []awstypes.LogSourceResource) (fwtypes.ListNestedObjectValueOf[subscriberSourceModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources := make([]*subscriberSourceModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		source := &subscriberSourceModel{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfNull[subscriberAWSLogSourceResourceModel](ctx),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfNull[subscriberCustomLogSourceResourceModel](ctx),
+		}
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			var tfObject subscriberAWSLogSourceResourceModel
+			diags.Append(flex.Flatten(ctx, v.Value, &tfObject)...)
+			source.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &tfObject)
+
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			var tfObject subscriberCustomLogSourceResourceModel
+			diags.Append(flex.Flatten(ctx, v.Value, &tfObject)...)
+			source.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &tfObject)
+
+		default:
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSlice(ctx, sources), diags
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -77,7 +77,7 @@ func dataSourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData,
 	poolName := aws.ToString(out.DedicatedIpPool.PoolName)
 	d.SetId(poolName)
 	d.Set("scaling_mode", string(out.DedicatedIpPool.ScalingMode))
-	d.Set("arn", poolNameToARN(meta, poolName))
+	d.Set("arn", poolNameToARN(ctx, meta, poolName))
 
 	outIP, err := findDedicatedIPPoolIPs(ctx, conn, poolName)
 	if err != nil {
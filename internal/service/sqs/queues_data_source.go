@@ -53,7 +53,7 @@ func dataSourceQueuesRead(ctx context.Context, d *schema.ResourceData, meta inte
 		queueURLs = append(queueURLs, page.QueueUrls...)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(d))
 	d.Set("queue_urls", queueURLs)
 
 	return nil
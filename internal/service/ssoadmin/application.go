@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application")
+// @Tags
+func newResourceApplication(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplication{}, nil
+}
+
+type resourceApplication struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceApplication) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application"
+}
+
+func (r *resourceApplication) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_account": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_arn": framework.ARNAttributeComputedOnly(),
+			"application_provider_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_token": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.ApplicationStatus_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"portal_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[portalOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"visibility": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.OneOf(ssoadmin.ApplicationVisibility_Values()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sign_in_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[signInOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_url": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 512),
+										},
+									},
+									"origin": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.SignInOrigin_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplication) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.CreateApplicationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationARN = fwflex.StringToFramework(ctx, output.ApplicationArn)
+	data.setID()
+
+	application, err := findApplicationByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ApplicationAccount = fwflex.StringToFramework(ctx, application.ApplicationAccount)
+	data.Status = fwflex.StringToFramework(ctx, application.Status)
+	if !data.PortalOptions.IsNull() {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, application.PortalOptions, &data.PortalOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceApplication) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := findApplicationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The API does not report the client token.
+	clientToken := data.ClientToken
+	// The API reports default portal options when none are configured.
+	portalOptions := data.PortalOptions
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ClientToken = clientToken
+	if portalOptions.IsNull() {
+		data.PortalOptions = portalOptions
+	}
+
+	tags, err := listTags(ctx, conn, data.ID.ValueString(), data.InstanceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplication) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new applicationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.PortalOptions.Equal(old.PortalOptions) ||
+		!new.Status.Equal(old.Status) {
+		input := &ssoadmin.UpdateApplicationInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := updateTags(ctx, conn, new.ID.ValueString(), new.InstanceARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for SSO Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceApplication) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	_, err := conn.DeleteApplicationWithContext(ctx, &ssoadmin.DeleteApplicationInput{
+		ApplicationArn: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApplication) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findApplicationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN string) (*ssoadmin.DescribeApplicationOutput, error) {
+	input := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(applicationARN),
+	}
+
+	output, err := conn.DescribeApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationResourceModel struct {
+	ApplicationAccount     types.String                                        `tfsdk:"application_account"`
+	ApplicationARN         types.String                                        `tfsdk:"application_arn"`
+	ApplicationProviderARN fwtypes.ARN                                         `tfsdk:"application_provider_arn"`
+	ClientToken            types.String                                        `tfsdk:"client_token"`
+	Description            types.String                                        `tfsdk:"description"`
+	ID                     types.String                                        `tfsdk:"id"`
+	InstanceARN            fwtypes.ARN                                         `tfsdk:"instance_arn"`
+	Name                   types.String                                        `tfsdk:"name"`
+	PortalOptions          fwtypes.ListNestedObjectValueOf[portalOptionsModel] `tfsdk:"portal_options"`
+	Status                 types.String                                        `tfsdk:"status"`
+	Tags                   types.Map                                           `tfsdk:"tags"`
+	TagsAll                types.Map                                           `tfsdk:"tags_all"`
+}
+
+func (data *applicationResourceModel) InitFromID() error {
+	data.ApplicationARN = data.ID
+
+	return nil
+}
+
+func (data *applicationResourceModel) setID() {
+	data.ID = data.ApplicationARN
+}
+
+type portalOptionsModel struct {
+	SignInOptions fwtypes.ListNestedObjectValueOf[signInOptionsModel] `tfsdk:"sign_in_options"`
+	Visibility    types.String                                        `tfsdk:"visibility"`
+}
+
+type signInOptionsModel struct {
+	ApplicationURL types.String `tfsdk:"application_url"`
+	Origin         types.String `tfsdk:"origin"`
+}
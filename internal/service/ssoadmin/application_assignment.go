@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Assignment")
+func newResourceApplicationAssignment(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignment{}, nil
+}
+
+type resourceApplicationAssignment struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceApplicationAssignment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_assignment"
+}
+
+func (r *resourceApplicationAssignment) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"principal_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 47),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.PrincipalType_Values()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationAssignment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationAssignmentResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.CreateApplicationAssignmentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateApplicationAssignmentWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application Assignment (%s)", data.ApplicationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	if err := data.setID(); err != nil {
+		response.Diagnostics.AddError("flattening resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceApplicationAssignment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationAssignmentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := findApplicationAssignmentByThreePartKey(ctx, conn, data.ApplicationARN.ValueString(), data.PrincipalID.ValueString(), data.PrincipalType.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Assignment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceApplicationAssignment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationAssignmentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	_, err := conn.DeleteApplicationAssignmentWithContext(ctx, &ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: aws.String(data.ApplicationARN.ValueString()),
+		PrincipalId:    aws.String(data.PrincipalID.ValueString()),
+		PrincipalType:  aws.String(data.PrincipalType.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Assignment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findApplicationAssignmentByThreePartKey(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN, principalID, principalType string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
+	input := &ssoadmin.DescribeApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	output, err := conn.DescribeApplicationAssignmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationAssignmentResourceModel struct {
+	ApplicationARN fwtypes.ARN  `tfsdk:"application_arn"`
+	ID             types.String `tfsdk:"id"`
+	PrincipalID    types.String `tfsdk:"principal_id"`
+	PrincipalType  types.String `tfsdk:"principal_type"`
+}
+
+const (
+	applicationAssignmentResourceIDPartCount = 3
+)
+
+func (data *applicationAssignmentResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), applicationAssignmentResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	if !arn.IsARN(parts[0]) {
+		return fmt.Errorf("unexpected format for ID (%s), expected APPLICATION_ARN%[2]sPRINCIPAL_ID%[2]sPRINCIPAL_TYPE", data.ID.ValueString(), flex.ResourceIdSeparator)
+	}
+
+	data.ApplicationARN = fwtypes.ARNValue(parts[0])
+	data.PrincipalID = types.StringValue(parts[1])
+	data.PrincipalType = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *applicationAssignmentResourceModel) setID() error {
+	parts := []string{
+		data.ApplicationARN.ValueString(),
+		data.PrincipalID.ValueString(),
+		data.PrincipalType.ValueString(),
+	}
+
+	id, err := flex.FlattenResourceId(parts, applicationAssignmentResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(id)
+
+	return nil
+}
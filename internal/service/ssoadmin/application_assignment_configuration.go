@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Assignment Configuration")
+func newResourceApplicationAssignmentConfiguration(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignmentConfiguration{}, nil
+}
+
+type resourceApplicationAssignmentConfiguration struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_assignment_configuration"
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignment_required": schema.BoolAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationAssignmentConfigurationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.PutApplicationAssignmentConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application Assignment Configuration (%s)", data.ApplicationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationAssignmentConfigurationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := findApplicationAssignmentConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Assignment Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data applicationAssignmentConfigurationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.PutApplicationAssignmentConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSO Application Assignment Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationAssignmentConfigurationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	// There is no delete API, so reset the configuration to the service default.
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(data.ID.ValueString()),
+		AssignmentRequired: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Assignment Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findApplicationAssignmentConfigurationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN string) (*ssoadmin.GetApplicationAssignmentConfigurationOutput, error) {
+	input := &ssoadmin.GetApplicationAssignmentConfigurationInput{
+		ApplicationArn: aws.String(applicationARN),
+	}
+
+	output, err := conn.GetApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationAssignmentConfigurationResourceModel struct {
+	ApplicationARN     fwtypes.ARN  `tfsdk:"application_arn"`
+	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
+	ID                 types.String `tfsdk:"id"`
+}
+
+func (data *applicationAssignmentConfigurationResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+
+	if !arn.IsARN(id) {
+		return fmt.Errorf("unexpected format for ID (%s), expected an application ARN", id)
+	}
+
+	data.ApplicationARN = fwtypes.ARNValue(id)
+
+	return nil
+}
+
+func (data *applicationAssignmentConfigurationResourceModel) setID() {
+	data.ID = data.ApplicationARN.StringValue
+}
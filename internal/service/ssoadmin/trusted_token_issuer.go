@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trusted Token Issuer")
+// @Tags
+func newResourceTrustedTokenIssuer(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTrustedTokenIssuer{}, nil
+}
+
+type resourceTrustedTokenIssuer struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceTrustedTokenIssuer) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_trusted_token_issuer"
+}
+
+func (r *resourceTrustedTokenIssuer) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"client_token": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trusted_token_issuer_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.TrustedTokenIssuerType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"trusted_token_issuer_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[trustedTokenIssuerConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"oidc_jwt_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oidcJWTConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"claim_attribute_path": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"identity_store_attribute_path": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"issuer_url": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"jwks_retrieval_option": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.JwksRetrievalOption_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustedTokenIssuerResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.CreateTrustedTokenIssuerInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTrustedTokenIssuerWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Trusted Token Issuer (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TrustedTokenIssuerARN = fwflex.StringToFramework(ctx, output.TrustedTokenIssuerArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustedTokenIssuerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := findTrustedTokenIssuerByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, data.ID.ValueString(), data.InstanceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustedTokenIssuerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	if !new.Name.Equal(old.Name) ||
+		!new.TrustedTokenIssuerConfiguration.Equal(old.TrustedTokenIssuerConfiguration) {
+		input := &ssoadmin.UpdateTrustedTokenIssuerInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTrustedTokenIssuerWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Trusted Token Issuer (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := updateTags(ctx, conn, new.ID.ValueString(), new.InstanceARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for SSO Trusted Token Issuer (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustedTokenIssuerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	_, err := conn.DeleteTrustedTokenIssuerWithContext(ctx, &ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, trustedTokenIssuerARN string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	input := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(trustedTokenIssuerARN),
+	}
+
+	output, err := conn.DescribeTrustedTokenIssuerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// trustedTokenIssuerParseInstanceARN returns the ARN of the IAM Identity Center instance
+// that owns the specified trusted token issuer.
+// Trusted token issuer ARNs have the form arn:PARTITION:sso::ACCOUNT:trustedTokenIssuer/INSTANCE_ID/ISSUER_ID.
+func trustedTokenIssuerParseInstanceARN(trustedTokenIssuerARN string) (string, error) {
+	v, err := arn.Parse(trustedTokenIssuerARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "trustedTokenIssuer" || parts[1] == "" || parts[2] == "" {
+		return "", fmt.Errorf("unexpected format for trusted token issuer ARN (%s)", trustedTokenIssuerARN)
+	}
+
+	return arn.ARN{
+		Partition: v.Partition,
+		Service:   v.Service,
+		Resource:  "instance/" + parts[1],
+	}.String(), nil
+}
+
+type trustedTokenIssuerResourceModel struct {
+	ClientToken                     types.String                                                          `tfsdk:"client_token"`
+	ID                              types.String                                                          `tfsdk:"id"`
+	InstanceARN                     fwtypes.ARN                                                           `tfsdk:"instance_arn"`
+	Name                            types.String                                                          `tfsdk:"name"`
+	Tags                            types.Map                                                             `tfsdk:"tags"`
+	TagsAll                         types.Map                                                             `tfsdk:"tags_all"`
+	TrustedTokenIssuerARN           types.String                                                          `tfsdk:"arn"`
+	TrustedTokenIssuerConfiguration fwtypes.ListNestedObjectValueOf[trustedTokenIssuerConfigurationModel] `tfsdk:"trusted_token_issuer_configuration"`
+	TrustedTokenIssuerType          types.String                                                          `tfsdk:"trusted_token_issuer_type"`
+}
+
+func (data *trustedTokenIssuerResourceModel) InitFromID() error {
+	instanceARN, err := trustedTokenIssuerParseInstanceARN(data.ID.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	data.InstanceARN = fwtypes.ARNValue(instanceARN)
+	data.TrustedTokenIssuerARN = data.ID
+
+	return nil
+}
+
+func (data *trustedTokenIssuerResourceModel) setID() {
+	data.ID = data.TrustedTokenIssuerARN
+}
+
+type trustedTokenIssuerConfigurationModel struct {
+	OIDCJWTConfiguration fwtypes.ListNestedObjectValueOf[oidcJWTConfigurationModel] `tfsdk:"oidc_jwt_configuration"`
+}
+
+type oidcJWTConfigurationModel struct {
+	ClaimAttributePath         types.String `tfsdk:"claim_attribute_path"`
+	IdentityStoreAttributePath types.String `tfsdk:"identity_store_attribute_path"`
+	IssuerURL                  types.String `tfsdk:"issuer_url"`
+	JWKSRetrievalOption        types.String `tfsdk:"jwks_retrieval_option"`
+}
@@ -376,7 +376,7 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 	canaryArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   synthetics.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(d),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
 	}.String()
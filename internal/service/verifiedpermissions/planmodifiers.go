@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+type suppressEquivalentJSONModifier struct{}
+
+// suppressEquivalentJSON returns a plan modifier that keeps the prior state value
+// when the planned value is a semantically equivalent JSON document.
+func suppressEquivalentJSON() planmodifier.String {
+	return suppressEquivalentJSONModifier{}
+}
+
+func (m suppressEquivalentJSONModifier) Description(context.Context) string {
+	return "Suppress differences between equivalent JSON documents"
+}
+
+func (m suppressEquivalentJSONModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m suppressEquivalentJSONModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	if request.StateValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	if verify.JSONStringsEqual(request.StateValue.ValueString(), request.PlanValue.ValueString()) {
+		response.PlanValue = request.StateValue
+	}
+}
+
+type suppressEquivalentCedarModifier struct{}
+
+// suppressEquivalentCedar returns a plan modifier that keeps the prior state value
+// when the planned value is a Cedar statement differing only in whitespace or comments.
+func suppressEquivalentCedar() planmodifier.String {
+	return suppressEquivalentCedarModifier{}
+}
+
+func (m suppressEquivalentCedarModifier) Description(context.Context) string {
+	return "Suppress whitespace and comment differences between Cedar statements"
+}
+
+func (m suppressEquivalentCedarModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m suppressEquivalentCedarModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	if request.StateValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	if cedarStatementsEqual(request.StateValue.ValueString(), request.PlanValue.ValueString()) {
+		response.PlanValue = request.StateValue
+	}
+}
+
+// cedarStatementsEqual reports whether two Cedar statements are equal ignoring
+// insignificant whitespace and comments.
+func cedarStatementsEqual(s1, s2 string) bool {
+	return normalizeCedar(s1) == normalizeCedar(s2)
+}
+
+// normalizeCedar strips comments and collapses whitespace outside of string literals.
+// A `//` comment runs to the end of its line and is treated as whitespace, so that
+// code following it on the next line is never folded into the comment.
+// Whitespace is dropped entirely when adjacent to punctuation, and otherwise
+// reduced to a single space so that identifiers and keywords stay separated.
+func normalizeCedar(s string) string {
+	var sb strings.Builder
+	var inString, inComment, escaped, pendingSpace bool
+	var prev rune
+
+	isPunct := func(r rune) bool {
+		return r != '_' && (unicode.IsPunct(r) || unicode.IsSymbol(r))
+	}
+
+	rs := []rune(s)
+	for i, r := range rs {
+		if inComment {
+			if r == '\n' {
+				inComment = false
+			}
+			continue
+		}
+
+		if inString {
+			sb.WriteRune(r)
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+			prev = r
+			continue
+		}
+
+		if r == '/' && i+1 < len(rs) && rs[i+1] == '/' {
+			inComment = true
+			pendingSpace = true
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			pendingSpace = true
+			continue
+		}
+
+		if pendingSpace && sb.Len() > 0 && !isPunct(prev) && !isPunct(r) {
+			sb.WriteRune(' ')
+		}
+		pendingSpace = false
+
+		if r == '"' {
+			inString = true
+		}
+		sb.WriteRune(r)
+		prev = r
+	}
+
+	return sb.String()
+}
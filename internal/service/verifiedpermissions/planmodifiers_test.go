@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"testing"
+)
+
+func TestCedarStatementsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		s1   string
+		s2   string
+		want bool
+	}{
+		{
+			name: "identical",
+			s1:   `permit (principal, action, resource);`,
+			s2:   `permit (principal, action, resource);`,
+			want: true,
+		},
+		{
+			name: "whitespace around punctuation",
+			s1:   `permit (principal, action, resource);`,
+			s2:   "permit(\n  principal,\n  action,\n  resource\n);\n",
+			want: true,
+		},
+		{
+			name: "collapsed whitespace between keywords",
+			s1:   `permit (principal, action, resource) when { principal in Group::"admins" };`,
+			s2:   "permit (principal, action, resource)\nwhen {\n  principal   in   Group::\"admins\"\n};",
+			want: true,
+		},
+		{
+			name: "whitespace in string literal",
+			s1:   `permit (principal == User::"alice", action, resource);`,
+			s2:   `permit (principal == User::"alice ", action, resource);`,
+			want: false,
+		},
+		{
+			name: "escaped quote in string literal",
+			s1:   `permit (principal == User::"a\" b", action, resource);`,
+			s2:   `permit (principal == User::"a\"  b", action, resource);`,
+			want: false,
+		},
+		{
+			name: "comments",
+			s1:   `permit (principal, action, resource) when { principal in resource };`,
+			s2:   "// Allow members.\npermit (principal, action, resource) // Everyone.\nwhen { principal in resource };\n",
+			want: true,
+		},
+		{
+			name: "code after comment",
+			s1:   "permit (principal, action, resource) // Everyone.\nwhen { principal in resource };",
+			s2:   `permit (principal, action, resource) // Everyone. when { principal in resource };`,
+			want: false,
+		},
+		{
+			name: "comment marker in string literal",
+			s1:   `permit (principal == User::"a//b", action, resource);`,
+			s2:   `permit (principal == User::"a", action, resource);`,
+			want: false,
+		},
+		{
+			name: "different effect",
+			s1:   `permit (principal, action, resource);`,
+			s2:   `forbid (principal, action, resource);`,
+			want: false,
+		},
+		{
+			name: "joined identifiers",
+			s1:   `permit (principal, action, resource) when { principal in resource };`,
+			s2:   `permit (principal, action, resource) when { principalin resource };`,
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := cedarStatementsEqual(testCase.s1, testCase.s2), testCase.want; got != want {
+				t.Errorf("cedarStatementsEqual(%q, %q) = %v, want %v", testCase.s1, testCase.s2, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Schema")
+func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSchema{}, nil
+}
+
+type resourceSchema struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSchema) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_schema"
+}
+
+func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespaces": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schemaDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								suppressEquivalentJSON(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSchema) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input, diags := data.putSchemaInput(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PutSchema(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Schema (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findSchemaByPolicyStoreID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	value := aws.ToString(output.Schema)
+
+	// Preserve the configured document if it is semantically equivalent to the one returned.
+	definition, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if definition == nil || !verify.JSONStringsEqual(definition.Value.ValueString(), value) {
+		data.Definition = fwtypes.NewListNestedObjectValueOfPtr(ctx, &schemaDefinitionModel{
+			Value: types.StringValue(value),
+		})
+	}
+
+	namespaces, err := schemaNamespaces(value)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		input, diags := new.putSchemaInput(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.PutSchema(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Schema (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+	} else {
+		new.Namespaces = old.Namespaces
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	// There is no API to delete a policy store's schema, so replace it with an empty one.
+	_, err := conn.PutSchema(ctx, &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: "{}",
+		},
+		PolicyStoreId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type schemaResourceModel struct {
+	Definition    fwtypes.ListNestedObjectValueOf[schemaDefinitionModel] `tfsdk:"definition"`
+	ID            types.String                                           `tfsdk:"id"`
+	Namespaces    types.Set                                              `tfsdk:"namespaces"`
+	PolicyStoreID types.String                                           `tfsdk:"policy_store_id"`
+}
+
+func (data *schemaResourceModel) InitFromID() {
+	data.PolicyStoreID = data.ID
+}
+
+func (data *schemaResourceModel) setID() {
+	data.ID = data.PolicyStoreID
+}
+
+func (data *schemaResourceModel) putSchemaInput(ctx context.Context) (*verifiedpermissions.PutSchemaInput, diag.Diagnostics) {
+	definition, diags := data.Definition.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: definition.Value.ValueString(),
+		},
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreID),
+	}, diags
+}
+
+type schemaDefinitionModel struct {
+	Value types.String `tfsdk:"value"`
+}
+
+func findSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A policy store whose schema has been removed reports an empty schema.
+	if output == nil || output.Schema == nil || aws.ToString(output.Schema) == "{}" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// schemaNamespaces returns the namespaces declared in a Cedar JSON schema.
+// Each top-level key of the schema document is a namespace.
+func schemaNamespaces(s string) ([]string, error) {
+	var m map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(m))
+	for k := range m {
+		namespaces = append(namespaces, k)
+	}
+
+	return namespaces, nil
+}
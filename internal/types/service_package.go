@@ -17,6 +17,13 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceImportByARN represents resource-level import-by-ARN information.
+type ServicePackageResourceImportByARN struct {
+	Service  string // The ARN service namespace, e.g. "eks".
+	Resource string // The format of the ARN resource part with named placeholders, e.g. "addon/{cluster_name}/{addon_name}/{}".
+	ID       string // The format of the resource ID in terms of the placeholders. Defaults to the single named placeholder.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +35,10 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory     func(context.Context) (resource.ResourceWithConfigure, error)
+	Name        string
+	Tags        *ServicePackageResourceTags
+	ImportByARN *ServicePackageResourceImportByARN
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -45,8 +53,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        *ServicePackageResourceTags
+	ImportByARN *ServicePackageResourceImportByARN
 }
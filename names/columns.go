@@ -30,4 +30,6 @@ const (
 	ColDeprecatedEnvVar        = 23
 	ColEnvVar                  = 24
 	ColNote                    = 25
+	ColGlobal                  = 26 // If set, the service's resources are global and not bound to a Region
+	ColEndpointsID             = 27 // AWS endpoint ID (endpoint prefix), if different from the provider package name
 )
@@ -26,6 +26,7 @@ const (
 	Backup                       = "backup"
 	Batch                        = "batch"
 	Bedrock                      = "bedrock"
+	BedrockAgent                 = "bedrockagent"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -130,6 +131,7 @@ const (
 	Lightsail                    = "lightsail"
 	Location                     = "location"
 	Logs                         = "logs"
+	M2                           = "m2"
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
@@ -33,6 +33,7 @@ const (
 	AthenaEndpointID                     = "athena"
 	AuditManagerEndpointID               = "auditmanager"
 	BedrockEndpointID                    = "bedrock"
+	BedrockAgentEndpointID               = "bedrock-agent"
 	ChimeSDKVoiceEndpointID              = "voice-chime"
 	CleanRoomsEndpointID                 = "cleanrooms"
 	CloudWatchLogsEndpointID             = "logs"
@@ -40,6 +41,7 @@ const (
 	CodeStarNotificationsEndpointID      = "codestar-notifications"
 	ComprehendEndpointID                 = "comprehend"
 	ComputeOptimizerEndpointID           = "computeoptimizer"
+	DocDBElasticEndpointID               = "docdb-elastic"
 	DSEndpointID                         = "ds"
 	EKSEndpointID                        = "eks"
 	EMRServerlessEndpointID              = "emrserverless"
@@ -52,8 +54,10 @@ const (
 	KeyspacesEndpointID                  = "keyspaces"
 	LambdaEndpointID                     = "lambda"
 	LexV2ModelsEndpointID                = "models-v2-lex"
+	M2EndpointID                         = "m2"
 	MediaLiveEndpointID                  = "medialive"
 	ObservabilityAccessManagerEndpointID = "oam"
+	OpenSearchIngestionEndpointID        = "osis"
 	OpenSearchServerlessEndpointID       = "aoss"
 	PipesEndpointID                      = "pipes"
 	PricingEndpointID                    = "pricing"
@@ -65,6 +69,7 @@ const (
 	RolesAnywhereEndpointID              = "rolesanywhere"
 	Route53DomainsEndpointID             = "route53domains"
 	SchedulerEndpointID                  = "scheduler"
+	SecurityLakeEndpointID               = "securitylake"
 	ServiceQuotasEndpointID              = "servicequotas"
 	S3EndpointID                         = "s3"
 	S3ControlEndpointID                  = "s3-control"
@@ -78,6 +83,7 @@ const (
 	SWFEndpointID                        = "swf"
 	TimestreamWriteEndpointID            = "ingest.timestream"
 	TranscribeEndpointID                 = "transcribe"
+	VerifiedPermissionsEndpointID        = "verifiedpermissions"
 	VPCLatticeEndpointID                 = "vpc-lattice"
 	XRayEndpointID                       = "xray"
 )
@@ -91,6 +97,8 @@ const (
 )
 
 const (
+	GlobalRegionID = "aws-global" // AWS Standard global region.
+
 	USEast1RegionID = "us-east-1" // US East (N. Virginia).
 	USWest1RegionID = "us-west-1" // US West (N. California).
 	USWest2RegionID = "us-west-2" // US West (Oregon).
@@ -106,7 +114,9 @@ type ServiceDatum struct {
 	Brand              string
 	DeprecatedEnvVar   string
 	EndpointOnly       bool
+	EndpointsID        string
 	EnvVar             string
+	Global             bool
 	GoV1ClientTypeName string
 	GoV1Package        string
 	GoV2Package        string
@@ -167,7 +177,9 @@ func readCSVIntoServiceData() error {
 			Brand:              l[ColBrand],
 			DeprecatedEnvVar:   l[ColDeprecatedEnvVar],
 			EndpointOnly:       l[ColEndpointOnly] != "",
+			EndpointsID:        l[ColEndpointsID],
 			EnvVar:             l[ColEnvVar],
+			Global:             l[ColGlobal] != "",
 			GoV1ClientTypeName: l[ColGoV1ClientTypeName],
 			GoV1Package:        l[ColGoV1Package],
 			GoV2Package:        l[ColGoV2Package],
@@ -289,6 +301,25 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// IsGlobal returns whether the specified service's resources are global rather than regional.
+func IsGlobal(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.Global
+	}
+
+	return false
+}
+
+// EndpointsID returns the specified service's AWS endpoint ID (endpoint prefix),
+// e.g. "monitoring" for CloudWatch, as used in the AWS partitions (endpoints.json) model.
+func EndpointsID(service string) string {
+	if v, ok := serviceData[service]; ok && v.EndpointsID != "" {
+		return v.EndpointsID
+	}
+
+	return service
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -345,6 +345,105 @@ func TestFullHumanFriendly(t *testing.T) {
 	}
 }
 
+func TestIsGlobal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: true,
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: true,
+		},
+		{
+			TestName: EKS,
+			Input:    EKS,
+			Expected: false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsGlobal(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestEndpointsID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "same as package",
+			Input:    EKS,
+			Expected: "eks",
+		},
+		{
+			TestName: CloudWatch,
+			Input:    CloudWatch,
+			Expected: "monitoring",
+		},
+		{
+			TestName: ConfigService,
+			Input:    ConfigService,
+			Expected: "config",
+		},
+		{
+			TestName: SageMaker,
+			Input:    SageMaker,
+			Expected: "api.sagemaker",
+		},
+		{
+			TestName: TimestreamWrite,
+			Input:    TimestreamWrite,
+			Expected: "ingest.timestream",
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "doesnotexist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := EndpointsID(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAWSGoV1Package(t *testing.T) {
 	t.Parallel()
 
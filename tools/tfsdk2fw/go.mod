@@ -35,6 +35,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/athena v1.34.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.29.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.3.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.7.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.14.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.27.0 // indirect